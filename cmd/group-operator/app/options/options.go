@@ -24,6 +24,8 @@ import (
 const (
 	GangSchedulerVolcano          = "volcano"
 	GangSchedulerSchedulerPlugins = "scheduler-plugins"
+	GangSchedulerYuniKorn         = "yunikorn"
+	GangSchedulerKueue            = "kueue"
 )
 
 // ServerOption is the main context object for the controller manager.
@@ -34,12 +36,20 @@ type ServerOption struct {
 	MonitoringPort      int
 	PrintVersion        bool
 	GangSchedulingName  string
+	QueueConfigFile     string
 	Namespace           string
 	LockNamespace       string
 	QPS                 int
 	Burst               int
 	ControllerRateLimit int
 	ControllerBurst     int
+
+	WebhookPort              int
+	WebhookNamespace         string
+	WebhookServiceName       string
+	WebhookSecretName        string
+	WebhookConfigurationName string
+	WebhookCRDName           string
 }
 
 // NewServerOption creates a new CMServer with a default config.
@@ -70,9 +80,13 @@ func (s *ServerOption) AddFlags(fs *flag.FlagSet) {
 		`Endpoint port for displaying monitoring metrics. It can be set to "0" to disable the metrics serving.`)
 
 	fs.StringVar(&s.GangSchedulingName, "gang-scheduling", "",
-		`Set gang scheduler name if enable gang scheduling. Now Supporting volcano and scheduler-plugins.
+		`Set gang scheduler name if enable gang scheduling. Now Supporting volcano, scheduler-plugins, yunikorn and kueue.
                 Note: If you set another scheduler name, the group-operator assumes it's the scheduler-plugins`)
 
+	fs.StringVar(&s.QueueConfigFile, "queue-config", "",
+		`Path to the configuration of the queue of the groupjobs, with the limits on the groupjobs running at once by namespace.
+                If set, new groupjobs are suspended until the queue admits them.`)
+
 	fs.StringVar(&s.LockNamespace, "lock-namespace", "group-operator", "Set locked namespace name while enabling leader election.")
 
 	fs.IntVar(&s.QPS, "kube-api-qps", 5, "QPS indicates the maximum QPS to the master from this client.")
@@ -80,4 +94,17 @@ func (s *ServerOption) AddFlags(fs *flag.FlagSet) {
 
 	fs.IntVar(&s.ControllerRateLimit, "controller-queue-rate-limit", 10, "Rate limit of the controller events queue .")
 	fs.IntVar(&s.ControllerBurst, "controller-queue-burst", 100, "Maximum burst of the controller events queue.")
+
+	fs.IntVar(&s.WebhookPort, "webhook-port", 0,
+		`Port for serving the admission and conversion webhooks, such as 9443. Defaults to "0", which disables the webhooks serving.`)
+	fs.StringVar(&s.WebhookNamespace, "webhook-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the webhook Service and certificate Secret. Defaults to the namespace of the operator pod.")
+	fs.StringVar(&s.WebhookServiceName, "webhook-service-name", "group-operator-webhook",
+		"Name of the Service fronting the admission webhooks.")
+	fs.StringVar(&s.WebhookSecretName, "webhook-secret-name", "group-operator-webhook-cert",
+		"Name of the Secret storing the self-signed webhook certificates.")
+	fs.StringVar(&s.WebhookConfigurationName, "webhook-configuration-name", "group-operator",
+		"Name of the Mutating and ValidatingWebhookConfigurations to inject the CA bundle into.")
+	fs.StringVar(&s.WebhookCRDName, "webhook-crd-name", "groupjobs.coreweave.com",
+		"Name of the GroupJob CustomResourceDefinition whose conversion webhook gets the CA bundle injected.")
 }
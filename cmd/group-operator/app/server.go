@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -50,6 +51,7 @@ import (
 	informers "github.com/coreweave/group-operator/pkg/client/informers/externalversions"
 	controllersv1 "github.com/coreweave/group-operator/pkg/controller"
 	"github.com/coreweave/group-operator/pkg/version"
+	"github.com/coreweave/group-operator/pkg/webhook"
 )
 
 const (
@@ -118,6 +120,13 @@ func Run(opt *options.ServerOption) error {
 	cfg.QPS = float32(opt.QPS)
 	cfg.Burst = opt.Burst
 
+	var queueConfig *controllersv1.QueueConfig
+	if len(opt.QueueConfigFile) != 0 {
+		if queueConfig, err = controllersv1.LoadQueueConfig(opt.QueueConfigFile); err != nil {
+			return err
+		}
+	}
+
 	// Create clients.
 	kubeClient, leaderElectionClientSet, mpiJobClientSet, volcanoClientSet, schedClientSet, err := createClientSets(cfg, opt.GangSchedulingName)
 	if err != nil {
@@ -161,13 +170,18 @@ func Run(opt *options.ServerOption) error {
 			kubeInformerFactory.Core().V1().Services(),
 			kubeInformerFactory.Batch().V1().Jobs(),
 			kubeInformerFactory.Core().V1().Pods(),
+			kubeInformerFactory.Core().V1().ServiceAccounts(),
+			kubeInformerFactory.Rbac().V1().Roles(),
+			kubeInformerFactory.Rbac().V1().RoleBindings(),
 			kubeInformerFactory.Scheduling().V1().PriorityClasses(),
+			kubeInformerFactory.Core().V1().Nodes(),
 			kubeflowInformerFactory.Kubeflow().V2beta1().GroupJobs(),
 			namespace, opt.GangSchedulingName,
 			workqueueRateLimiter)
 		if err != nil {
 			klog.Fatalf("Failed to setup the controller")
 		}
+		controller.QueueConfig = queueConfig
 
 		go kubeInformerFactory.Start(ctx.Done())
 		go kubeflowInformerFactory.Start(ctx.Done())
@@ -213,6 +227,28 @@ func Run(opt *options.ServerOption) error {
 		}
 	}()
 
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// The webhooks are served by every replica, not only the leader.
+	if opt.WebhookPort > 0 {
+		crdClient, err := apiextensionsclientset.NewForConfig(restclientset.AddUserAgent(cfg, "group-operator"))
+		if err != nil {
+			return fmt.Errorf("error building apiextensions clientset: %s", err.Error())
+		}
+		if err := startWebhookServer(ctx, opt, kubeClient, crdClient, mpiJobClientSet); err != nil {
+			return err
+		}
+	}
+
 	rl := &resourcelock.LeaseLock{
 		LeaseMeta: metav1.ObjectMeta{
 			Namespace: opt.LockNamespace,
@@ -225,17 +261,6 @@ func Run(opt *options.ServerOption) error {
 		},
 	}
 
-	ctx, cancel := context.WithCancel(context.TODO())
-	defer cancel()
-
-	go func() {
-		select {
-		case <-stopCh:
-			cancel()
-		case <-ctx.Done():
-		}
-	}()
-
 	// Start leader election.
 	election.RunOrDie(ctx, election.LeaderElectionConfig{
 		Lock:          rl,
@@ -265,6 +290,29 @@ func Run(opt *options.ServerOption) error {
 	return fmt.Errorf("finished without leader elect")
 }
 
+func startWebhookServer(ctx context.Context, opt *options.ServerOption, kubeClient kubeclientset.Interface, crdClient apiextensionsclientset.Interface, mpiJobClient mpijobclientset.Interface) error {
+	if opt.WebhookNamespace == "" {
+		return fmt.Errorf("--webhook-namespace or the POD_NAMESPACE env is required to serve the webhooks")
+	}
+	certs := webhook.NewCertManager(kubeClient, crdClient, webhook.CertConfig{
+		Namespace:                opt.WebhookNamespace,
+		ServiceName:              opt.WebhookServiceName,
+		SecretName:               opt.WebhookSecretName,
+		WebhookConfigurationName: opt.WebhookConfigurationName,
+		CRDName:                  opt.WebhookCRDName,
+	})
+	if err := certs.Ensure(ctx); err != nil {
+		return fmt.Errorf("failed to set up the webhook certificates: %v", err)
+	}
+	go certs.Run(ctx)
+	go func() {
+		if err := webhook.NewServer(opt.WebhookPort, certs, mpiJobClient).Run(ctx); err != nil {
+			klog.Fatalf("Error serving the webhooks: %v", err)
+		}
+	}()
+	return nil
+}
+
 func createClientSets(
 	config *restclientset.Config,
 	gangSchedulingName string,
@@ -296,11 +344,14 @@ func createClientSets(
 		volcanoClientSet volcanoclient.Interface
 		schedClientSet   schedclientset.Interface
 	)
-	if gangSchedulingName == options.GangSchedulerVolcano {
+	switch gangSchedulingName {
+	case "", options.GangSchedulerYuniKorn, options.GangSchedulerKueue:
+		// These gang-schedulers have no PodGroup API.
+	case options.GangSchedulerVolcano:
 		if volcanoClientSet, err = volcanoclient.NewForConfig(restclientset.AddUserAgent(config, "volcano")); err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
-	} else if len(gangSchedulingName) != 0 {
+	default:
 		if schedClientSet, err = schedclientset.NewForConfig(restclientset.AddUserAgent(config, "scheduler-plugins")); err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
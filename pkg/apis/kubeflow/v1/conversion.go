@@ -0,0 +1,181 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/conversion"
+
+	"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// Convert_v1_GroupJobSpec_To_v2beta1_GroupJobSpec maps the launcher, the
+// default worker group and the named worker groups into mpiReplicaSpecs.
+func Convert_v1_GroupJobSpec_To_v2beta1_GroupJobSpec(in *GroupJobSpec, out *v2beta1.GroupJobSpec, s conversion.Scope) error {
+	if err := autoConvert_v1_GroupJobSpec_To_v2beta1_GroupJobSpec(in, out, s); err != nil {
+		return err
+	}
+	out.MPIReplicaSpecs = nil
+	if in.Launcher == nil && in.Worker == nil && len(in.WorkerGroups) == 0 {
+		return nil
+	}
+	out.MPIReplicaSpecs = make(map[v2beta1.MPIReplicaType]*v2beta1.ReplicaSpec, len(in.WorkerGroups)+2)
+	add := func(rType v2beta1.MPIReplicaType, spec *ReplicaSpec) error {
+		if _, ok := out.MPIReplicaSpecs[rType]; ok {
+			return fmt.Errorf("worker group %q conflicts with another replica", rType)
+		}
+		outSpec := &v2beta1.ReplicaSpec{}
+		if err := Convert_v1_ReplicaSpec_To_v2beta1_ReplicaSpec(spec, outSpec, s); err != nil {
+			return err
+		}
+		out.MPIReplicaSpecs[rType] = outSpec
+		return nil
+	}
+	if in.Launcher != nil {
+		if err := add(v2beta1.MPIReplicaTypeLauncher, in.Launcher); err != nil {
+			return err
+		}
+	}
+	if in.Worker != nil {
+		if err := add(v2beta1.MPIReplicaTypeWorker, in.Worker); err != nil {
+			return err
+		}
+	}
+	for i := range in.WorkerGroups {
+		group := &in.WorkerGroups[i]
+		if err := add(v2beta1.MPIReplicaType(group.Name), &group.ReplicaSpec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v2beta1_GroupJobSpec_To_v1_GroupJobSpec splits mpiReplicaSpecs into
+// the launcher, the default worker group and the named worker groups, sorted
+// by name.
+func Convert_v2beta1_GroupJobSpec_To_v1_GroupJobSpec(in *v2beta1.GroupJobSpec, out *GroupJobSpec, s conversion.Scope) error {
+	if err := autoConvert_v2beta1_GroupJobSpec_To_v1_GroupJobSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Launcher, out.Worker, out.WorkerGroups = nil, nil, nil
+	for _, rType := range sortedReplicaTypes(in.MPIReplicaSpecs) {
+		spec := in.MPIReplicaSpecs[rType]
+		if spec == nil {
+			continue
+		}
+		outSpec := ReplicaSpec{}
+		if err := Convert_v2beta1_ReplicaSpec_To_v1_ReplicaSpec(spec, &outSpec, s); err != nil {
+			return err
+		}
+		switch rType {
+		case v2beta1.MPIReplicaTypeLauncher:
+			out.Launcher = &outSpec
+		case v2beta1.MPIReplicaTypeWorker:
+			out.Worker = &outSpec
+		default:
+			out.WorkerGroups = append(out.WorkerGroups, WorkerGroupSpec{
+				Name:        string(rType),
+				ReplicaSpec: outSpec,
+			})
+		}
+	}
+	return nil
+}
+
+// Convert_v1_JobStatus_To_v2beta1_JobStatus maps the status of the launcher
+// and of the worker groups into replicaStatuses.
+func Convert_v1_JobStatus_To_v2beta1_JobStatus(in *JobStatus, out *v2beta1.JobStatus, s conversion.Scope) error {
+	if err := autoConvert_v1_JobStatus_To_v2beta1_JobStatus(in, out, s); err != nil {
+		return err
+	}
+	out.ReplicaStatuses = nil
+	if in.Launcher == nil && in.Worker == nil && len(in.WorkerGroups) == 0 {
+		return nil
+	}
+	out.ReplicaStatuses = make(map[v2beta1.MPIReplicaType]*v2beta1.ReplicaStatus, len(in.WorkerGroups)+2)
+	add := func(rType v2beta1.MPIReplicaType, status *ReplicaStatus) error {
+		if _, ok := out.ReplicaStatuses[rType]; ok {
+			return fmt.Errorf("status of worker group %q conflicts with another replica", rType)
+		}
+		outStatus := &v2beta1.ReplicaStatus{}
+		if err := Convert_v1_ReplicaStatus_To_v2beta1_ReplicaStatus(status, outStatus, s); err != nil {
+			return err
+		}
+		out.ReplicaStatuses[rType] = outStatus
+		return nil
+	}
+	if in.Launcher != nil {
+		if err := add(v2beta1.MPIReplicaTypeLauncher, in.Launcher); err != nil {
+			return err
+		}
+	}
+	if in.Worker != nil {
+		if err := add(v2beta1.MPIReplicaTypeWorker, in.Worker); err != nil {
+			return err
+		}
+	}
+	for i := range in.WorkerGroups {
+		group := &in.WorkerGroups[i]
+		if err := add(v2beta1.MPIReplicaType(group.Name), &group.ReplicaStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v2beta1_JobStatus_To_v1_JobStatus splits replicaStatuses into the
+// status of the launcher, of the default worker group and of the named worker
+// groups, sorted by name.
+func Convert_v2beta1_JobStatus_To_v1_JobStatus(in *v2beta1.JobStatus, out *JobStatus, s conversion.Scope) error {
+	if err := autoConvert_v2beta1_JobStatus_To_v1_JobStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Launcher, out.Worker, out.WorkerGroups = nil, nil, nil
+	for _, rType := range sortedReplicaTypes(in.ReplicaStatuses) {
+		status := in.ReplicaStatuses[rType]
+		if status == nil {
+			continue
+		}
+		outStatus := ReplicaStatus{}
+		if err := Convert_v2beta1_ReplicaStatus_To_v1_ReplicaStatus(status, &outStatus, s); err != nil {
+			return err
+		}
+		switch rType {
+		case v2beta1.MPIReplicaTypeLauncher:
+			out.Launcher = &outStatus
+		case v2beta1.MPIReplicaTypeWorker:
+			out.Worker = &outStatus
+		default:
+			out.WorkerGroups = append(out.WorkerGroups, WorkerGroupStatus{
+				Name:          string(rType),
+				ReplicaStatus: outStatus,
+			})
+		}
+	}
+	return nil
+}
+
+func sortedReplicaTypes[T any](m map[v2beta1.MPIReplicaType]T) []v2beta1.MPIReplicaType {
+	types := make([]v2beta1.MPIReplicaType, 0, len(m))
+	for rType := range m {
+		types = append(types, rType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	fuzz "github.com/google/gofuzz"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const fuzzIterations = 1000
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v2beta1.AddToScheme(scheme))
+	utilruntime.Must(AddToScheme(scheme))
+	return scheme
+}
+
+// newFuzzer returns a fuzzer of valid GroupJobs of both versions. Replica
+// specs and statuses are never nil, and the worker groups of v1 GroupJobs
+// are unique, don't use the names of the launcher and the default group, and
+// are in the order they are converted to, since they are a map keyed by name.
+func newFuzzer(seed int64) *fuzz.Fuzzer {
+	return fuzz.New().NilChance(.2).NumElements(0, 3).RandSource(rand.NewSource(seed)).Funcs(
+		func(q *resource.Quantity, c fuzz.Continue) {
+			*q = *resource.NewQuantity(c.Int63n(1000), resource.DecimalSI)
+		},
+		func(j *v2beta1.GroupJob, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			j.TypeMeta = metav1.TypeMeta{}
+		},
+		func(j *GroupJob, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			j.TypeMeta = metav1.TypeMeta{}
+		},
+		func(s *v2beta1.GroupJobSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			for rType, spec := range s.MPIReplicaSpecs {
+				if spec == nil {
+					delete(s.MPIReplicaSpecs, rType)
+				}
+			}
+		},
+		func(s *v2beta1.JobStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			for rType, status := range s.ReplicaStatuses {
+				if status == nil {
+					delete(s.ReplicaStatuses, rType)
+				}
+			}
+		},
+		func(s *GroupJobSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			s.WorkerGroups = uniqueGroups(s.WorkerGroups, func(g WorkerGroupSpec) string { return g.Name })
+		},
+		func(s *JobStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			s.WorkerGroups = uniqueGroups(s.WorkerGroups, func(g WorkerGroupStatus) string { return g.Name })
+		},
+	)
+}
+
+func uniqueGroups[T any](groups []T, name func(T) string) []T {
+	seen := map[string]bool{
+		string(v2beta1.MPIReplicaTypeLauncher): true,
+		string(v2beta1.MPIReplicaTypeWorker):   true,
+	}
+	var unique []T
+	for _, g := range groups {
+		if !seen[name(g)] {
+			seen[name(g)] = true
+			unique = append(unique, g)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		return name(unique[i]) < name(unique[j])
+	})
+	return unique
+}
+
+func TestFuzzRoundTrip(t *testing.T) {
+	scheme := newScheme()
+	seed := time.Now().UnixNano()
+	t.Logf("Fuzzing with seed %d", seed)
+	f := newFuzzer(seed)
+
+	for i := 0; i < fuzzIterations; i++ {
+		hub := &v2beta1.GroupJob{}
+		f.Fuzz(hub)
+		spoke := &GroupJob{}
+		if err := scheme.Convert(hub, spoke, nil); err != nil {
+			t.Fatalf("Converting v2beta1 to v1: %v", err)
+		}
+		gotHub := &v2beta1.GroupJob{}
+		if err := scheme.Convert(spoke, gotHub, nil); err != nil {
+			t.Fatalf("Converting v1 to v2beta1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(hub, gotHub) {
+			t.Fatalf("v2beta1 round trip lost data (-want,+got):\n%s", cmp.Diff(hub, gotHub))
+		}
+
+		spoke = &GroupJob{}
+		f.Fuzz(spoke)
+		hub = &v2beta1.GroupJob{}
+		if err := scheme.Convert(spoke, hub, nil); err != nil {
+			t.Fatalf("Converting v1 to v2beta1: %v", err)
+		}
+		gotSpoke := &GroupJob{}
+		if err := scheme.Convert(hub, gotSpoke, nil); err != nil {
+			t.Fatalf("Converting v2beta1 to v1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(spoke, gotSpoke) {
+			t.Fatalf("v1 round trip lost data (-want,+got):\n%s", cmp.Diff(spoke, gotSpoke))
+		}
+	}
+}
+
+func TestConvertToV1(t *testing.T) {
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Image: "foo"}},
+		},
+	}
+	in := &v2beta1.GroupJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Generation: 2},
+		Spec: v2beta1.GroupJobSpec{
+			SlotsPerWorker:    ptr.To[int32](2),
+			MPIImplementation: v2beta1.MPIImplementationOpenMPI,
+			MPIReplicaSpecs: map[v2beta1.MPIReplicaType]*v2beta1.ReplicaSpec{
+				v2beta1.MPIReplicaTypeLauncher: {Replicas: ptr.To[int32](1), Template: template},
+				v2beta1.MPIReplicaTypeWorker:   {Replicas: ptr.To[int32](2), Template: template},
+				"GPU":                          {Replicas: ptr.To[int32](4), Template: template},
+				"CPU":                          {Replicas: ptr.To[int32](1), Template: template},
+			},
+		},
+		Status: v2beta1.JobStatus{
+			ObservedGeneration: 2,
+			ReplicaStatuses: map[v2beta1.MPIReplicaType]*v2beta1.ReplicaStatus{
+				v2beta1.MPIReplicaTypeLauncher: {Active: 1},
+				v2beta1.MPIReplicaTypeWorker:   {Active: 2, Selector: "foo"},
+				"GPU":                          {Failed: 1},
+			},
+		},
+	}
+	want := &GroupJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Generation: 2},
+		Spec: GroupJobSpec{
+			SlotsPerWorker:    ptr.To[int32](2),
+			MPIImplementation: MPIImplementationOpenMPI,
+			Launcher:          &ReplicaSpec{Replicas: ptr.To[int32](1), Template: template},
+			Worker:            &ReplicaSpec{Replicas: ptr.To[int32](2), Template: template},
+			WorkerGroups: []WorkerGroupSpec{
+				{Name: "CPU", ReplicaSpec: ReplicaSpec{Replicas: ptr.To[int32](1), Template: template}},
+				{Name: "GPU", ReplicaSpec: ReplicaSpec{Replicas: ptr.To[int32](4), Template: template}},
+			},
+		},
+		Status: JobStatus{
+			ObservedGeneration: 2,
+			Launcher:           &ReplicaStatus{Active: 1},
+			Worker:             &ReplicaStatus{Active: 2, Selector: "foo"},
+			WorkerGroups: []WorkerGroupStatus{
+				{Name: "GPU", ReplicaStatus: ReplicaStatus{Failed: 1}},
+			},
+		},
+	}
+	got := &GroupJob{}
+	if err := newScheme().Convert(in, got, nil); err != nil {
+		t.Fatalf("Converting v2beta1 to v1: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected v1 GroupJob (-want,+got):\n%s", diff)
+	}
+}
+
+func TestConvertConflictingWorkerGroups(t *testing.T) {
+	in := &GroupJob{
+		Spec: GroupJobSpec{
+			Worker: &ReplicaSpec{Replicas: ptr.To[int32](1)},
+			WorkerGroups: []WorkerGroupSpec{
+				{Name: "Worker", ReplicaSpec: ReplicaSpec{Replicas: ptr.To[int32](2)}},
+			},
+		},
+	}
+	if err := newScheme().Convert(in, &v2beta1.GroupJob{}, nil); err == nil {
+		t.Error("Expected an error converting a worker group named after the default group")
+	}
+}
@@ -0,0 +1,566 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.worker.replicas,statuspath=.status.worker.active,selectorpath=.status.worker.selector
+// +kubebuilder:printcolumn:name="PodGroup",type=string,JSONPath=`.status.podGroupPhase`
+// +kubebuilder:printcolumn:name="Scheduling",type=string,JSONPath=`.status.conditions[?(@.type=="GangScheduled")].message`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GroupJob is the v1 version of the GroupJob. It is converted to and from
+// the v2beta1 version, which is the storage version, by the conversion
+// webhook of the group-operator.
+type GroupJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GroupJobSpec `json:"spec,omitempty"`
+	Status            JobStatus    `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+type GroupJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []GroupJob `json:"items"`
+}
+
+// CleanPodPolicy describes how to deal with pods when the job is finished.
+type CleanPodPolicy string
+
+const (
+	CleanPodPolicyUndefined CleanPodPolicy = ""
+	CleanPodPolicyAll       CleanPodPolicy = "All"
+	CleanPodPolicyRunning   CleanPodPolicy = "Running"
+	CleanPodPolicyNone      CleanPodPolicy = "None"
+)
+
+// SchedulingPolicy encapsulates various scheduling policies of the distributed training
+// job, for example `minAvailable` for gang-scheduling.
+type SchedulingPolicy struct {
+	// MinAvailable defines the minimal number of member to run the PodGroup.
+	// If not set, it defaults to the number of workers.
+	// +optional
+	MinAvailable *int32 `json:"minAvailable,omitempty"`
+
+	// Queue defines the queue name to allocate resource for PodGroup.
+	// +optional
+	Queue string `json:"queue,omitempty"`
+
+	// MinResources defines the minimal resources of members to run the PodGroup.
+	// +optional
+	MinResources *corev1.ResourceList `json:"minResources,omitempty"`
+
+	// PriorityClass defines the PodGroup's PriorityClass.
+	// +optional
+	PriorityClass string `json:"priorityClass,omitempty"`
+
+	// SchedulerTimeoutSeconds defines the maximal time of members to wait before run the PodGroup.
+	// +optional
+	ScheduleTimeoutSeconds *int32 `json:"scheduleTimeoutSeconds,omitempty"`
+
+	// ScheduleTimeoutAction defines what the controller does when the
+	// scheduleTimeoutSeconds is exceeded. Options are "Fail" (default) and
+	// "Requeue".
+	// +kubebuilder:validation:Enum:=Fail;Requeue
+	// +optional
+	ScheduleTimeoutAction ScheduleTimeoutAction `json:"scheduleTimeoutAction,omitempty"`
+
+	// TopologyKey is the key of the node label defining the topology domains
+	// the workers are packed in and the hostfile is ordered by.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+type ScheduleTimeoutAction string
+
+const (
+	ScheduleTimeoutActionFail    ScheduleTimeoutAction = "Fail"
+	ScheduleTimeoutActionRequeue ScheduleTimeoutAction = "Requeue"
+)
+
+// RunPolicy encapsulates various runtime policies of the distributed training
+// job, for example how to clean up resources and how long the job can stay
+// active.
+type RunPolicy struct {
+	// CleanPodPolicy defines the policy to kill pods after the job completes.
+	// Default to Running.
+	CleanPodPolicy *CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+
+	// CleanupPolicy defines what is cleaned up once the GroupJob finished.
+	// +optional
+	CleanupPolicy *CleanupPolicy `json:"cleanupPolicy,omitempty"`
+
+	// TTLSecondsAfterFinished is the TTL to clean up jobs.
+	// Default to infinite.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Specifies the duration in seconds relative to the startTime that the job may be active
+	// before the system tries to terminate it; value must be positive integer.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Optional number of retries before marking this job failed.
+	// It also caps the number of workers restarted after a retryable exit code,
+	// or the number of group restarts when RestartMode is Group.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// RestartMode defines how the GroupJob recovers from failed pods.
+	// Options are "Pod" and "Group". Defaults to "Pod".
+	// +kubebuilder:validation:Enum:=Pod;Group
+	// +optional
+	RestartMode *RestartMode `json:"restartMode,omitempty"`
+
+	// NodeFailureLimit is the number of worker failures on a node after which
+	// the workers created again avoid the node.
+	// +optional
+	NodeFailureLimit *int32 `json:"nodeFailureLimit,omitempty"`
+
+	// FailurePolicy overrides how the GroupJob reacts to failed workers
+	// depending on the cause of their failure.
+	// +listType=map
+	// +listMapKey=cause
+	// +optional
+	FailurePolicy []FailurePolicyRule `json:"failurePolicy,omitempty"`
+
+	// SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling
+	// +optional
+	SchedulingPolicy *SchedulingPolicy `json:"schedulingPolicy,omitempty"`
+
+	// suspend specifies whether the GroupJob controller should create Pods or not.
+	// Defaults to false.
+	// +kubebuilder:default:=false
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// SuspendGracePeriodSeconds is how long the running pods get to
+	// checkpoint when the GroupJob is suspended.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuspendGracePeriodSeconds *int32 `json:"suspendGracePeriodSeconds,omitempty"`
+
+	// DebugHoldSeconds is how long the pods are kept after a failure.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DebugHoldSeconds *int32 `json:"debugHoldSeconds,omitempty"`
+
+	// ManagedBy is used to indicate the controller or entity that manages a GroupJob.
+	// The value must be either empty, 'kubeflow.org/group-operator' or
+	// 'kueue.x-k8s.io/multikueue'.
+	// The field is immutable.
+	// +optional
+	ManagedBy *string `json:"managedBy,omitempty"`
+}
+
+// CleanupPolicy defines what is cleaned up once the GroupJob finished.
+type CleanupPolicy struct {
+	// +kubebuilder:validation:Enum:=None;Running;All
+	Workers *CleanPodPolicy `json:"workers,omitempty"`
+	// +kubebuilder:validation:Enum:=None;Running;All
+	Launcher *CleanPodPolicy `json:"launcher,omitempty"`
+	// +kubebuilder:validation:Enum:=None;All
+	Resources *CleanPodPolicy `json:"resources,omitempty"`
+}
+
+// RestartMode describes how the GroupJob recovers from failed pods.
+type RestartMode string
+
+const (
+	RestartModePod   RestartMode = "Pod"
+	RestartModeGroup RestartMode = "Group"
+)
+
+type FailurePolicyRule struct {
+	// +kubebuilder:validation:Enum:=Preempted;Evicted;OOMKilled;NodeLost;Error
+	Cause FailureCause `json:"cause"`
+	// +kubebuilder:validation:Enum:=Restart;Fail
+	Action FailureAction `json:"action"`
+}
+
+type FailureCause string
+
+const (
+	FailureCausePreempted FailureCause = "Preempted"
+	FailureCauseEvicted   FailureCause = "Evicted"
+	FailureCauseOOMKilled FailureCause = "OOMKilled"
+	FailureCauseNodeLost  FailureCause = "NodeLost"
+	FailureCauseError     FailureCause = "Error"
+)
+
+type FailureAction string
+
+const (
+	FailureActionRestart FailureAction = "Restart"
+	FailureActionFail    FailureAction = "Fail"
+)
+
+type LauncherCreationPolicy string
+
+const (
+	LauncherCreationPolicyAtStartup           LauncherCreationPolicy = "AtStartup"
+	LauncherCreationPolicyWaitForWorkersReady LauncherCreationPolicy = "WaitForWorkersReady"
+)
+
+type BootstrapMode string
+
+const (
+	BootstrapModeSSH  BootstrapMode = "SSH"
+	BootstrapModeExec BootstrapMode = "Exec"
+)
+
+type SSHKeyAlgorithm string
+
+const (
+	SSHKeyAlgorithmECDSA   SSHKeyAlgorithm = "ECDSA"
+	SSHKeyAlgorithmEd25519 SSHKeyAlgorithm = "Ed25519"
+	SSHKeyAlgorithmRSA     SSHKeyAlgorithm = "RSA"
+)
+
+type GroupJobSpec struct {
+	// Specifies the number of slots per worker used in hostfile.
+	// Defaults to 1.
+	// +optional
+	// +kubebuilder:default:=1
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
+	// RunLauncherAsWorker indicates whether to run worker process in launcher
+	// Defaults to false.
+	// +optional
+	// +kubebuilder:default:=false
+	RunLauncherAsWorker *bool `json:"runLauncherAsWorker,omitempty"`
+
+	// RunPolicy encapsulates various runtime policies of the job.
+	RunPolicy RunPolicy `json:"runPolicy,omitempty"`
+
+	// Launcher is the replica that runs mpirun.
+	Launcher *ReplicaSpec `json:"launcher,omitempty"`
+
+	// Worker is the default group of workers. Its replicas are exposed
+	// through the scale subresource.
+	// +optional
+	Worker *ReplicaSpec `json:"worker,omitempty"`
+
+	// WorkerGroups are additional named groups of workers, each with its own
+	// template, replicas and slotsPerWorker, so that a GroupJob can mix
+	// different node types. They are listed in the hostfile after the
+	// default group, in the order of their names.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	WorkerGroups []WorkerGroupSpec `json:"workerGroups,omitempty"`
+
+	// SSHAuthMountPath is the directory where SSH keys are mounted.
+	// Defaults to "/root/.ssh".
+	// +kubebuilder:default:="/root/.ssh"
+	SSHAuthMountPath string `json:"sshAuthMountPath,omitempty"`
+
+	// BootstrapMode is how the launcher starts the processes on the workers.
+	// "SSH" (default) runs sshd on the workers and mounts a generated SSH key.
+	// "Exec" starts them with kubectl exec, which must be available in the
+	// launcher image, using a ServiceAccount created for the GroupJob that
+	// can only exec into its workers. No sshd runs and no SSH key is created.
+	// +kubebuilder:validation:Enum:=SSH;Exec
+	// +kubebuilder:default:=SSH
+	// +optional
+	BootstrapMode BootstrapMode `json:"bootstrapMode,omitempty"`
+
+	// SSHAuthSecretName is the name of an existing Secret in the namespace of
+	// the GroupJob holding the SSH keys in its "ssh-privatekey" and
+	// "ssh-publickey" entries, so that a key can be shared across GroupJobs.
+	// When set, the operator generates no SSH key, nor the host key and the
+	// known_hosts file of the workers. Only supported in the SSH bootstrap
+	// mode.
+	// +optional
+	SSHAuthSecretName string `json:"sshAuthSecretName,omitempty"`
+
+	// SSHKeyAlgorithm is the algorithm of the SSH keys generated for the
+	// GroupJob. Options are "ECDSA" (default, on the P-521 curve), "Ed25519"
+	// and "RSA" (4096 bits).
+	// +kubebuilder:validation:Enum:=ECDSA;Ed25519;RSA
+	// +kubebuilder:default:=ECDSA
+	// +optional
+	SSHKeyAlgorithm SSHKeyAlgorithm `json:"sshKeyAlgorithm,omitempty"`
+
+	// SSHRunAsNonRoot runs sshd as the user of the worker containers on
+	// SSHPort, with an sshd_config generated by the operator, instead of as
+	// root. The launcher and the workers also get the security context
+	// required by the restricted Pod Security Standard, where not set. Only
+	// supported in the SSH bootstrap mode, with the SSH keys generated by the
+	// operator.
+	// +optional
+	SSHRunAsNonRoot *bool `json:"sshRunAsNonRoot,omitempty"`
+
+	// SSHPort is the unprivileged port sshd listens on when SSHRunAsNonRoot
+	// is set. Defaults to 2222.
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	SSHPort *int32 `json:"sshPort,omitempty"`
+
+	// launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.
+	// +kubebuilder:validation:Enum:AtStartup;WaitForWorkersReady
+	// +kubebuilder:default:=AtStartup
+	LauncherCreationPolicy LauncherCreationPolicy `json:"launcherCreationPolicy,omitempty"`
+
+	// MPIImplementation is the MPI implementation.
+	// Options are "OpenMPI" (default), "Intel", "MPICH", "MVAPICH2" and
+	// "CrayMPICH".
+	// +kubebuilder:validation:Enum:=OpenMPI;Intel;MPICH;MVAPICH2;CrayMPICH
+	// +kubebuilder:default:=OpenMPI
+	MPIImplementation MPIImplementation `json:"mpiImplementation,omitempty"`
+}
+
+// WorkerGroupSpec is a named group of workers.
+type WorkerGroupSpec struct {
+	// Name of the group. It must be a DNS-1035 label, and it can't be
+	// "Launcher" or "Worker", which are the launcher and the default group.
+	Name string `json:"name"`
+
+	ReplicaSpec `json:",inline"`
+}
+
+type MPIImplementation string
+
+const (
+	MPIImplementationOpenMPI   MPIImplementation = "OpenMPI"
+	MPIImplementationIntel     MPIImplementation = "Intel"
+	MPIImplementationMPICH     MPIImplementation = "MPICH"
+	MPIImplementationMVAPICH2  MPIImplementation = "MVAPICH2"
+	MPIImplementationCrayMPICH MPIImplementation = "CrayMPICH"
+)
+
+// JobStatus represents the current observed state of the training Job.
+type JobStatus struct {
+	// observedGeneration is the generation of the GroupJob that the
+	// controller last reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// conditions is a list of current observed job conditions.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []JobCondition `json:"conditions,omitempty"`
+
+	// launcher is the status of the launcher.
+	// +optional
+	Launcher *ReplicaStatus `json:"launcher,omitempty"`
+
+	// worker is the status of the default group of workers.
+	// +optional
+	Worker *ReplicaStatus `json:"worker,omitempty"`
+
+	// workerGroups is the status of each additional group of workers.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	WorkerGroups []WorkerGroupStatus `json:"workerGroups,omitempty"`
+
+	// Represents time when the job was acknowledged by the job controller.
+	// It is represented in RFC3339 form and is in UTC.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Represents time when the job was completed.
+	// It is represented in RFC3339 form and is in UTC.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Represents last time when the job was reconciled.
+	// It is represented in RFC3339 form and is in UTC.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// restartCount is the number of times the launcher Job and all the workers
+	// were torn down and recreated when RestartMode is Group, or the number
+	// of failed workers that were restarted when RestartMode is Pod.
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// lastRestartTime is the last time a failed worker was counted in
+	// restartCount when RestartMode is Pod.
+	// +optional
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+
+	// elasticReplicas is the number of workers of an elastic GroupJob that
+	// are running and listed in the hostfile.
+	// +optional
+	ElasticReplicas *int32 `json:"elasticReplicas,omitempty"`
+
+	// podGroupPhase is the phase of the PodGroup of the GroupJob, when
+	// gang-scheduling is enabled, regardless of the gang-scheduler.
+	// +optional
+	PodGroupPhase PodGroupPhase `json:"podGroupPhase,omitempty"`
+
+	// nodeFailures are the nodes whose loss or evictions failed workers of
+	// the GroupJob.
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	NodeFailures []NodeFailure `json:"nodeFailures,omitempty"`
+}
+
+type NodeFailure struct {
+	NodeName        string      `json:"nodeName"`
+	Failures        int32       `json:"failures"`
+	LastFailureTime metav1.Time `json:"lastFailureTime"`
+}
+
+type PodGroupPhase string
+
+const (
+	PodGroupPending       PodGroupPhase = "Pending"
+	PodGroupInqueue       PodGroupPhase = "Inqueue"
+	PodGroupRunning       PodGroupPhase = "Running"
+	PodGroupUnschedulable PodGroupPhase = "Unschedulable"
+)
+
+// WorkerGroupStatus is the status of a named group of workers.
+type WorkerGroupStatus struct {
+	// Name of the group.
+	Name string `json:"name"`
+
+	ReplicaStatus `json:",inline"`
+}
+
+// ReplicaStatus represents the current observed state of the replica.
+type ReplicaStatus struct {
+	// The number of actively running pods.
+	// +optional
+	Active int32 `json:"active,omitempty"`
+
+	// The number of pods which reached phase succeeded.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// The number of pods which reached phase failed.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// The number of failed pods by the cause of their failure.
+	// +optional
+	FailedByCause map[FailureCause]int32 `json:"failedByCause,omitempty"`
+
+	// Deprecated: Use selector instead
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// A selector is a label query over the pods of the replica.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+}
+
+// JobCondition describes the state of the job at a certain point.
+type JobCondition struct {
+	// type of job condition.
+	Type JobConditionType `json:"type"`
+
+	// status of the condition, one of True, False, Unknown.
+	// +kubebuilder:validation:Enum:=True;False;Unknown
+	Status corev1.ConditionStatus `json:"status"`
+
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// A human-readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// The last time this condition was updated.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// JobConditionType defines all kinds of types of JobStatus.
+type JobConditionType string
+
+const (
+	JobCreated       JobConditionType = "Created"
+	JobRunning       JobConditionType = "Running"
+	JobRestarting    JobConditionType = "Restarting"
+	JobSucceeded     JobConditionType = "Succeeded"
+	JobSuspended     JobConditionType = "Suspended"
+	JobSuspending    JobConditionType = "Suspending"
+	JobDebugHold     JobConditionType = "DebugHold"
+	JobFailed        JobConditionType = "Failed"
+	JobGangScheduled JobConditionType = "GangScheduled"
+	JobQueued        JobConditionType = "Queued"
+)
+
+// ReplicaSpec is a description of the replica
+type ReplicaSpec struct {
+	// Replicas is the desired number of replicas of the given template.
+	// If unspecified, defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// MinReplicas is the minimum number of running workers of an elastic
+	// GroupJob. Only valid for the default group of workers.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of workers of an elastic GroupJob.
+	// Only valid for the default group of workers.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// SlotsPerWorker is the number of slots per worker of this group used in
+	// the hostfile. It overrides .spec.slotsPerWorker.
+	// Not valid for the launcher.
+	// +optional
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
+	// Template is the object that describes the pod that
+	// will be created for this replica.
+	Template corev1.PodTemplateSpec `json:"template,omitempty"`
+
+	// Restart policy for all replicas within the job.
+	// One of Always, OnFailure, Never and ExitCode.
+	// Default to Never.
+	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// RetryableExitCodes is the set of container exit codes that are treated
+	// as retryable when RestartPolicy is ExitCode.
+	// If unspecified, exit codes 128-255 are retryable.
+	// +optional
+	// +listType=set
+	RetryableExitCodes []int32 `json:"retryableExitCodes,omitempty"`
+}
+
+// RestartPolicy describes how the replicas should be restarted.
+type RestartPolicy string
+
+const (
+	RestartPolicyAlways    RestartPolicy = "Always"
+	RestartPolicyOnFailure RestartPolicy = "OnFailure"
+	RestartPolicyNever     RestartPolicy = "Never"
+	RestartPolicyExitCode  RestartPolicy = "ExitCode"
+)
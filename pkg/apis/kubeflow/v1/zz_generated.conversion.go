@@ -0,0 +1,542 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	unsafe "unsafe"
+
+	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CleanupPolicy)(nil), (*v2beta1.CleanupPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CleanupPolicy_To_v2beta1_CleanupPolicy(a.(*CleanupPolicy), b.(*v2beta1.CleanupPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.CleanupPolicy)(nil), (*CleanupPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_CleanupPolicy_To_v1_CleanupPolicy(a.(*v2beta1.CleanupPolicy), b.(*CleanupPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FailurePolicyRule)(nil), (*v2beta1.FailurePolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FailurePolicyRule_To_v2beta1_FailurePolicyRule(a.(*FailurePolicyRule), b.(*v2beta1.FailurePolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.FailurePolicyRule)(nil), (*FailurePolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_FailurePolicyRule_To_v1_FailurePolicyRule(a.(*v2beta1.FailurePolicyRule), b.(*FailurePolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GroupJob)(nil), (*v2beta1.GroupJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GroupJob_To_v2beta1_GroupJob(a.(*GroupJob), b.(*v2beta1.GroupJob), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.GroupJob)(nil), (*GroupJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_GroupJob_To_v1_GroupJob(a.(*v2beta1.GroupJob), b.(*GroupJob), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GroupJobList)(nil), (*v2beta1.GroupJobList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GroupJobList_To_v2beta1_GroupJobList(a.(*GroupJobList), b.(*v2beta1.GroupJobList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.GroupJobList)(nil), (*GroupJobList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_GroupJobList_To_v1_GroupJobList(a.(*v2beta1.GroupJobList), b.(*GroupJobList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GroupJobSpec)(nil), (*v2beta1.GroupJobSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GroupJobSpec_To_v2beta1_GroupJobSpec(a.(*GroupJobSpec), b.(*v2beta1.GroupJobSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.GroupJobSpec)(nil), (*GroupJobSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_GroupJobSpec_To_v1_GroupJobSpec(a.(*v2beta1.GroupJobSpec), b.(*GroupJobSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*JobCondition)(nil), (*v2beta1.JobCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_JobCondition_To_v2beta1_JobCondition(a.(*JobCondition), b.(*v2beta1.JobCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.JobCondition)(nil), (*JobCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_JobCondition_To_v1_JobCondition(a.(*v2beta1.JobCondition), b.(*JobCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*JobStatus)(nil), (*v2beta1.JobStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_JobStatus_To_v2beta1_JobStatus(a.(*JobStatus), b.(*v2beta1.JobStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.JobStatus)(nil), (*JobStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_JobStatus_To_v1_JobStatus(a.(*v2beta1.JobStatus), b.(*JobStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeFailure)(nil), (*v2beta1.NodeFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NodeFailure_To_v2beta1_NodeFailure(a.(*NodeFailure), b.(*v2beta1.NodeFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.NodeFailure)(nil), (*NodeFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_NodeFailure_To_v1_NodeFailure(a.(*v2beta1.NodeFailure), b.(*NodeFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicaSpec)(nil), (*v2beta1.ReplicaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicaSpec_To_v2beta1_ReplicaSpec(a.(*ReplicaSpec), b.(*v2beta1.ReplicaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.ReplicaSpec)(nil), (*ReplicaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_ReplicaSpec_To_v1_ReplicaSpec(a.(*v2beta1.ReplicaSpec), b.(*ReplicaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicaStatus)(nil), (*v2beta1.ReplicaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicaStatus_To_v2beta1_ReplicaStatus(a.(*ReplicaStatus), b.(*v2beta1.ReplicaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.ReplicaStatus)(nil), (*ReplicaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_ReplicaStatus_To_v1_ReplicaStatus(a.(*v2beta1.ReplicaStatus), b.(*ReplicaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RunPolicy)(nil), (*v2beta1.RunPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RunPolicy_To_v2beta1_RunPolicy(a.(*RunPolicy), b.(*v2beta1.RunPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.RunPolicy)(nil), (*RunPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_RunPolicy_To_v1_RunPolicy(a.(*v2beta1.RunPolicy), b.(*RunPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingPolicy)(nil), (*v2beta1.SchedulingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SchedulingPolicy_To_v2beta1_SchedulingPolicy(a.(*SchedulingPolicy), b.(*v2beta1.SchedulingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v2beta1.SchedulingPolicy)(nil), (*SchedulingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v2beta1_SchedulingPolicy_To_v1_SchedulingPolicy(a.(*v2beta1.SchedulingPolicy), b.(*SchedulingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1_CleanupPolicy_To_v2beta1_CleanupPolicy(in *CleanupPolicy, out *v2beta1.CleanupPolicy, s conversion.Scope) error {
+	out.Workers = (*v2beta1.CleanPodPolicy)(unsafe.Pointer(in.Workers))
+	out.Launcher = (*v2beta1.CleanPodPolicy)(unsafe.Pointer(in.Launcher))
+	out.Resources = (*v2beta1.CleanPodPolicy)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1_CleanupPolicy_To_v2beta1_CleanupPolicy is an autogenerated conversion function.
+func Convert_v1_CleanupPolicy_To_v2beta1_CleanupPolicy(in *CleanupPolicy, out *v2beta1.CleanupPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CleanupPolicy_To_v2beta1_CleanupPolicy(in, out, s)
+}
+
+func autoConvert_v2beta1_CleanupPolicy_To_v1_CleanupPolicy(in *v2beta1.CleanupPolicy, out *CleanupPolicy, s conversion.Scope) error {
+	out.Workers = (*CleanPodPolicy)(unsafe.Pointer(in.Workers))
+	out.Launcher = (*CleanPodPolicy)(unsafe.Pointer(in.Launcher))
+	out.Resources = (*CleanPodPolicy)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v2beta1_CleanupPolicy_To_v1_CleanupPolicy is an autogenerated conversion function.
+func Convert_v2beta1_CleanupPolicy_To_v1_CleanupPolicy(in *v2beta1.CleanupPolicy, out *CleanupPolicy, s conversion.Scope) error {
+	return autoConvert_v2beta1_CleanupPolicy_To_v1_CleanupPolicy(in, out, s)
+}
+
+func autoConvert_v1_FailurePolicyRule_To_v2beta1_FailurePolicyRule(in *FailurePolicyRule, out *v2beta1.FailurePolicyRule, s conversion.Scope) error {
+	out.Cause = v2beta1.FailureCause(in.Cause)
+	out.Action = v2beta1.FailureAction(in.Action)
+	return nil
+}
+
+// Convert_v1_FailurePolicyRule_To_v2beta1_FailurePolicyRule is an autogenerated conversion function.
+func Convert_v1_FailurePolicyRule_To_v2beta1_FailurePolicyRule(in *FailurePolicyRule, out *v2beta1.FailurePolicyRule, s conversion.Scope) error {
+	return autoConvert_v1_FailurePolicyRule_To_v2beta1_FailurePolicyRule(in, out, s)
+}
+
+func autoConvert_v2beta1_FailurePolicyRule_To_v1_FailurePolicyRule(in *v2beta1.FailurePolicyRule, out *FailurePolicyRule, s conversion.Scope) error {
+	out.Cause = FailureCause(in.Cause)
+	out.Action = FailureAction(in.Action)
+	return nil
+}
+
+// Convert_v2beta1_FailurePolicyRule_To_v1_FailurePolicyRule is an autogenerated conversion function.
+func Convert_v2beta1_FailurePolicyRule_To_v1_FailurePolicyRule(in *v2beta1.FailurePolicyRule, out *FailurePolicyRule, s conversion.Scope) error {
+	return autoConvert_v2beta1_FailurePolicyRule_To_v1_FailurePolicyRule(in, out, s)
+}
+
+func autoConvert_v1_GroupJob_To_v2beta1_GroupJob(in *GroupJob, out *v2beta1.GroupJob, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_GroupJobSpec_To_v2beta1_GroupJobSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_JobStatus_To_v2beta1_JobStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_GroupJob_To_v2beta1_GroupJob is an autogenerated conversion function.
+func Convert_v1_GroupJob_To_v2beta1_GroupJob(in *GroupJob, out *v2beta1.GroupJob, s conversion.Scope) error {
+	return autoConvert_v1_GroupJob_To_v2beta1_GroupJob(in, out, s)
+}
+
+func autoConvert_v2beta1_GroupJob_To_v1_GroupJob(in *v2beta1.GroupJob, out *GroupJob, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v2beta1_GroupJobSpec_To_v1_GroupJobSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v2beta1_JobStatus_To_v1_JobStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v2beta1_GroupJob_To_v1_GroupJob is an autogenerated conversion function.
+func Convert_v2beta1_GroupJob_To_v1_GroupJob(in *v2beta1.GroupJob, out *GroupJob, s conversion.Scope) error {
+	return autoConvert_v2beta1_GroupJob_To_v1_GroupJob(in, out, s)
+}
+
+func autoConvert_v1_GroupJobList_To_v2beta1_GroupJobList(in *GroupJobList, out *v2beta1.GroupJobList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v2beta1.GroupJob, len(*in))
+		for i := range *in {
+			if err := Convert_v1_GroupJob_To_v2beta1_GroupJob(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_GroupJobList_To_v2beta1_GroupJobList is an autogenerated conversion function.
+func Convert_v1_GroupJobList_To_v2beta1_GroupJobList(in *GroupJobList, out *v2beta1.GroupJobList, s conversion.Scope) error {
+	return autoConvert_v1_GroupJobList_To_v2beta1_GroupJobList(in, out, s)
+}
+
+func autoConvert_v2beta1_GroupJobList_To_v1_GroupJobList(in *v2beta1.GroupJobList, out *GroupJobList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupJob, len(*in))
+		for i := range *in {
+			if err := Convert_v2beta1_GroupJob_To_v1_GroupJob(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v2beta1_GroupJobList_To_v1_GroupJobList is an autogenerated conversion function.
+func Convert_v2beta1_GroupJobList_To_v1_GroupJobList(in *v2beta1.GroupJobList, out *GroupJobList, s conversion.Scope) error {
+	return autoConvert_v2beta1_GroupJobList_To_v1_GroupJobList(in, out, s)
+}
+
+func autoConvert_v1_GroupJobSpec_To_v2beta1_GroupJobSpec(in *GroupJobSpec, out *v2beta1.GroupJobSpec, s conversion.Scope) error {
+	out.SlotsPerWorker = (*int32)(unsafe.Pointer(in.SlotsPerWorker))
+	out.RunLauncherAsWorker = (*bool)(unsafe.Pointer(in.RunLauncherAsWorker))
+	if err := Convert_v1_RunPolicy_To_v2beta1_RunPolicy(&in.RunPolicy, &out.RunPolicy, s); err != nil {
+		return err
+	}
+	// WARNING: in.Launcher requires manual conversion: does not exist in peer-type
+	// WARNING: in.Worker requires manual conversion: does not exist in peer-type
+	// WARNING: in.WorkerGroups requires manual conversion: does not exist in peer-type
+	out.SSHAuthMountPath = in.SSHAuthMountPath
+	out.BootstrapMode = v2beta1.BootstrapMode(in.BootstrapMode)
+	out.SSHAuthSecretName = in.SSHAuthSecretName
+	out.SSHKeyAlgorithm = v2beta1.SSHKeyAlgorithm(in.SSHKeyAlgorithm)
+	out.SSHRunAsNonRoot = (*bool)(unsafe.Pointer(in.SSHRunAsNonRoot))
+	out.SSHPort = (*int32)(unsafe.Pointer(in.SSHPort))
+	out.LauncherCreationPolicy = v2beta1.LauncherCreationPolicy(in.LauncherCreationPolicy)
+	out.MPIImplementation = v2beta1.MPIImplementation(in.MPIImplementation)
+	return nil
+}
+
+func autoConvert_v2beta1_GroupJobSpec_To_v1_GroupJobSpec(in *v2beta1.GroupJobSpec, out *GroupJobSpec, s conversion.Scope) error {
+	out.SlotsPerWorker = (*int32)(unsafe.Pointer(in.SlotsPerWorker))
+	out.RunLauncherAsWorker = (*bool)(unsafe.Pointer(in.RunLauncherAsWorker))
+	if err := Convert_v2beta1_RunPolicy_To_v1_RunPolicy(&in.RunPolicy, &out.RunPolicy, s); err != nil {
+		return err
+	}
+	// WARNING: in.MPIReplicaSpecs requires manual conversion: does not exist in peer-type
+	out.SSHAuthMountPath = in.SSHAuthMountPath
+	out.BootstrapMode = BootstrapMode(in.BootstrapMode)
+	out.SSHAuthSecretName = in.SSHAuthSecretName
+	out.SSHKeyAlgorithm = SSHKeyAlgorithm(in.SSHKeyAlgorithm)
+	out.SSHRunAsNonRoot = (*bool)(unsafe.Pointer(in.SSHRunAsNonRoot))
+	out.SSHPort = (*int32)(unsafe.Pointer(in.SSHPort))
+	out.LauncherCreationPolicy = LauncherCreationPolicy(in.LauncherCreationPolicy)
+	out.MPIImplementation = MPIImplementation(in.MPIImplementation)
+	return nil
+}
+
+func autoConvert_v1_JobCondition_To_v2beta1_JobCondition(in *JobCondition, out *v2beta1.JobCondition, s conversion.Scope) error {
+	out.Type = v2beta1.JobConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_JobCondition_To_v2beta1_JobCondition is an autogenerated conversion function.
+func Convert_v1_JobCondition_To_v2beta1_JobCondition(in *JobCondition, out *v2beta1.JobCondition, s conversion.Scope) error {
+	return autoConvert_v1_JobCondition_To_v2beta1_JobCondition(in, out, s)
+}
+
+func autoConvert_v2beta1_JobCondition_To_v1_JobCondition(in *v2beta1.JobCondition, out *JobCondition, s conversion.Scope) error {
+	out.Type = JobConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v2beta1_JobCondition_To_v1_JobCondition is an autogenerated conversion function.
+func Convert_v2beta1_JobCondition_To_v1_JobCondition(in *v2beta1.JobCondition, out *JobCondition, s conversion.Scope) error {
+	return autoConvert_v2beta1_JobCondition_To_v1_JobCondition(in, out, s)
+}
+
+func autoConvert_v1_JobStatus_To_v2beta1_JobStatus(in *JobStatus, out *v2beta1.JobStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]v2beta1.JobCondition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Launcher requires manual conversion: does not exist in peer-type
+	// WARNING: in.Worker requires manual conversion: does not exist in peer-type
+	// WARNING: in.WorkerGroups requires manual conversion: does not exist in peer-type
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.LastReconcileTime = (*metav1.Time)(unsafe.Pointer(in.LastReconcileTime))
+	out.RestartCount = in.RestartCount
+	out.LastRestartTime = (*metav1.Time)(unsafe.Pointer(in.LastRestartTime))
+	out.ElasticReplicas = (*int32)(unsafe.Pointer(in.ElasticReplicas))
+	out.PodGroupPhase = v2beta1.PodGroupPhase(in.PodGroupPhase)
+	out.NodeFailures = *(*[]v2beta1.NodeFailure)(unsafe.Pointer(&in.NodeFailures))
+	return nil
+}
+
+func autoConvert_v2beta1_JobStatus_To_v1_JobStatus(in *v2beta1.JobStatus, out *JobStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]JobCondition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.ReplicaStatuses requires manual conversion: does not exist in peer-type
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.LastReconcileTime = (*metav1.Time)(unsafe.Pointer(in.LastReconcileTime))
+	out.RestartCount = in.RestartCount
+	out.LastRestartTime = (*metav1.Time)(unsafe.Pointer(in.LastRestartTime))
+	out.ElasticReplicas = (*int32)(unsafe.Pointer(in.ElasticReplicas))
+	out.PodGroupPhase = PodGroupPhase(in.PodGroupPhase)
+	out.NodeFailures = *(*[]NodeFailure)(unsafe.Pointer(&in.NodeFailures))
+	return nil
+}
+
+func autoConvert_v1_NodeFailure_To_v2beta1_NodeFailure(in *NodeFailure, out *v2beta1.NodeFailure, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	out.Failures = in.Failures
+	out.LastFailureTime = in.LastFailureTime
+	return nil
+}
+
+// Convert_v1_NodeFailure_To_v2beta1_NodeFailure is an autogenerated conversion function.
+func Convert_v1_NodeFailure_To_v2beta1_NodeFailure(in *NodeFailure, out *v2beta1.NodeFailure, s conversion.Scope) error {
+	return autoConvert_v1_NodeFailure_To_v2beta1_NodeFailure(in, out, s)
+}
+
+func autoConvert_v2beta1_NodeFailure_To_v1_NodeFailure(in *v2beta1.NodeFailure, out *NodeFailure, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	out.Failures = in.Failures
+	out.LastFailureTime = in.LastFailureTime
+	return nil
+}
+
+// Convert_v2beta1_NodeFailure_To_v1_NodeFailure is an autogenerated conversion function.
+func Convert_v2beta1_NodeFailure_To_v1_NodeFailure(in *v2beta1.NodeFailure, out *NodeFailure, s conversion.Scope) error {
+	return autoConvert_v2beta1_NodeFailure_To_v1_NodeFailure(in, out, s)
+}
+
+func autoConvert_v1_ReplicaSpec_To_v2beta1_ReplicaSpec(in *ReplicaSpec, out *v2beta1.ReplicaSpec, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.SlotsPerWorker = (*int32)(unsafe.Pointer(in.SlotsPerWorker))
+	out.Template = in.Template
+	out.RestartPolicy = v2beta1.RestartPolicy(in.RestartPolicy)
+	out.RetryableExitCodes = *(*[]int32)(unsafe.Pointer(&in.RetryableExitCodes))
+	return nil
+}
+
+// Convert_v1_ReplicaSpec_To_v2beta1_ReplicaSpec is an autogenerated conversion function.
+func Convert_v1_ReplicaSpec_To_v2beta1_ReplicaSpec(in *ReplicaSpec, out *v2beta1.ReplicaSpec, s conversion.Scope) error {
+	return autoConvert_v1_ReplicaSpec_To_v2beta1_ReplicaSpec(in, out, s)
+}
+
+func autoConvert_v2beta1_ReplicaSpec_To_v1_ReplicaSpec(in *v2beta1.ReplicaSpec, out *ReplicaSpec, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.SlotsPerWorker = (*int32)(unsafe.Pointer(in.SlotsPerWorker))
+	out.Template = in.Template
+	out.RestartPolicy = RestartPolicy(in.RestartPolicy)
+	out.RetryableExitCodes = *(*[]int32)(unsafe.Pointer(&in.RetryableExitCodes))
+	return nil
+}
+
+// Convert_v2beta1_ReplicaSpec_To_v1_ReplicaSpec is an autogenerated conversion function.
+func Convert_v2beta1_ReplicaSpec_To_v1_ReplicaSpec(in *v2beta1.ReplicaSpec, out *ReplicaSpec, s conversion.Scope) error {
+	return autoConvert_v2beta1_ReplicaSpec_To_v1_ReplicaSpec(in, out, s)
+}
+
+func autoConvert_v1_ReplicaStatus_To_v2beta1_ReplicaStatus(in *ReplicaStatus, out *v2beta1.ReplicaStatus, s conversion.Scope) error {
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.FailedByCause = *(*map[v2beta1.FailureCause]int32)(unsafe.Pointer(&in.FailedByCause))
+	out.LabelSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	out.Selector = in.Selector
+	return nil
+}
+
+// Convert_v1_ReplicaStatus_To_v2beta1_ReplicaStatus is an autogenerated conversion function.
+func Convert_v1_ReplicaStatus_To_v2beta1_ReplicaStatus(in *ReplicaStatus, out *v2beta1.ReplicaStatus, s conversion.Scope) error {
+	return autoConvert_v1_ReplicaStatus_To_v2beta1_ReplicaStatus(in, out, s)
+}
+
+func autoConvert_v2beta1_ReplicaStatus_To_v1_ReplicaStatus(in *v2beta1.ReplicaStatus, out *ReplicaStatus, s conversion.Scope) error {
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.FailedByCause = *(*map[FailureCause]int32)(unsafe.Pointer(&in.FailedByCause))
+	out.LabelSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	out.Selector = in.Selector
+	return nil
+}
+
+// Convert_v2beta1_ReplicaStatus_To_v1_ReplicaStatus is an autogenerated conversion function.
+func Convert_v2beta1_ReplicaStatus_To_v1_ReplicaStatus(in *v2beta1.ReplicaStatus, out *ReplicaStatus, s conversion.Scope) error {
+	return autoConvert_v2beta1_ReplicaStatus_To_v1_ReplicaStatus(in, out, s)
+}
+
+func autoConvert_v1_RunPolicy_To_v2beta1_RunPolicy(in *RunPolicy, out *v2beta1.RunPolicy, s conversion.Scope) error {
+	out.CleanPodPolicy = (*v2beta1.CleanPodPolicy)(unsafe.Pointer(in.CleanPodPolicy))
+	out.CleanupPolicy = (*v2beta1.CleanupPolicy)(unsafe.Pointer(in.CleanupPolicy))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.RestartMode = (*v2beta1.RestartMode)(unsafe.Pointer(in.RestartMode))
+	out.NodeFailureLimit = (*int32)(unsafe.Pointer(in.NodeFailureLimit))
+	out.FailurePolicy = *(*[]v2beta1.FailurePolicyRule)(unsafe.Pointer(&in.FailurePolicy))
+	out.SchedulingPolicy = (*v2beta1.SchedulingPolicy)(unsafe.Pointer(in.SchedulingPolicy))
+	out.Suspend = (*bool)(unsafe.Pointer(in.Suspend))
+	out.SuspendGracePeriodSeconds = (*int32)(unsafe.Pointer(in.SuspendGracePeriodSeconds))
+	out.DebugHoldSeconds = (*int32)(unsafe.Pointer(in.DebugHoldSeconds))
+	out.ManagedBy = (*string)(unsafe.Pointer(in.ManagedBy))
+	return nil
+}
+
+// Convert_v1_RunPolicy_To_v2beta1_RunPolicy is an autogenerated conversion function.
+func Convert_v1_RunPolicy_To_v2beta1_RunPolicy(in *RunPolicy, out *v2beta1.RunPolicy, s conversion.Scope) error {
+	return autoConvert_v1_RunPolicy_To_v2beta1_RunPolicy(in, out, s)
+}
+
+func autoConvert_v2beta1_RunPolicy_To_v1_RunPolicy(in *v2beta1.RunPolicy, out *RunPolicy, s conversion.Scope) error {
+	out.CleanPodPolicy = (*CleanPodPolicy)(unsafe.Pointer(in.CleanPodPolicy))
+	out.CleanupPolicy = (*CleanupPolicy)(unsafe.Pointer(in.CleanupPolicy))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.RestartMode = (*RestartMode)(unsafe.Pointer(in.RestartMode))
+	out.NodeFailureLimit = (*int32)(unsafe.Pointer(in.NodeFailureLimit))
+	out.FailurePolicy = *(*[]FailurePolicyRule)(unsafe.Pointer(&in.FailurePolicy))
+	out.SchedulingPolicy = (*SchedulingPolicy)(unsafe.Pointer(in.SchedulingPolicy))
+	out.Suspend = (*bool)(unsafe.Pointer(in.Suspend))
+	out.SuspendGracePeriodSeconds = (*int32)(unsafe.Pointer(in.SuspendGracePeriodSeconds))
+	out.DebugHoldSeconds = (*int32)(unsafe.Pointer(in.DebugHoldSeconds))
+	out.ManagedBy = (*string)(unsafe.Pointer(in.ManagedBy))
+	return nil
+}
+
+// Convert_v2beta1_RunPolicy_To_v1_RunPolicy is an autogenerated conversion function.
+func Convert_v2beta1_RunPolicy_To_v1_RunPolicy(in *v2beta1.RunPolicy, out *RunPolicy, s conversion.Scope) error {
+	return autoConvert_v2beta1_RunPolicy_To_v1_RunPolicy(in, out, s)
+}
+
+func autoConvert_v1_SchedulingPolicy_To_v2beta1_SchedulingPolicy(in *SchedulingPolicy, out *v2beta1.SchedulingPolicy, s conversion.Scope) error {
+	out.MinAvailable = (*int32)(unsafe.Pointer(in.MinAvailable))
+	out.Queue = in.Queue
+	out.MinResources = (*corev1.ResourceList)(unsafe.Pointer(in.MinResources))
+	out.PriorityClass = in.PriorityClass
+	out.ScheduleTimeoutSeconds = (*int32)(unsafe.Pointer(in.ScheduleTimeoutSeconds))
+	out.ScheduleTimeoutAction = v2beta1.ScheduleTimeoutAction(in.ScheduleTimeoutAction)
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+// Convert_v1_SchedulingPolicy_To_v2beta1_SchedulingPolicy is an autogenerated conversion function.
+func Convert_v1_SchedulingPolicy_To_v2beta1_SchedulingPolicy(in *SchedulingPolicy, out *v2beta1.SchedulingPolicy, s conversion.Scope) error {
+	return autoConvert_v1_SchedulingPolicy_To_v2beta1_SchedulingPolicy(in, out, s)
+}
+
+func autoConvert_v2beta1_SchedulingPolicy_To_v1_SchedulingPolicy(in *v2beta1.SchedulingPolicy, out *SchedulingPolicy, s conversion.Scope) error {
+	out.MinAvailable = (*int32)(unsafe.Pointer(in.MinAvailable))
+	out.Queue = in.Queue
+	out.MinResources = (*corev1.ResourceList)(unsafe.Pointer(in.MinResources))
+	out.PriorityClass = in.PriorityClass
+	out.ScheduleTimeoutSeconds = (*int32)(unsafe.Pointer(in.ScheduleTimeoutSeconds))
+	out.ScheduleTimeoutAction = ScheduleTimeoutAction(in.ScheduleTimeoutAction)
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+// Convert_v2beta1_SchedulingPolicy_To_v1_SchedulingPolicy is an autogenerated conversion function.
+func Convert_v2beta1_SchedulingPolicy_To_v1_SchedulingPolicy(in *v2beta1.SchedulingPolicy, out *SchedulingPolicy, s conversion.Scope) error {
+	return autoConvert_v2beta1_SchedulingPolicy_To_v1_SchedulingPolicy(in, out, s)
+}
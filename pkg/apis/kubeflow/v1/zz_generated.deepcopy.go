@@ -0,0 +1,514 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupPolicy) DeepCopyInto(out *CleanupPolicy) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	if in.Launcher != nil {
+		in, out := &in.Launcher, &out.Launcher
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicy.
+func (in *CleanupPolicy) DeepCopy() *CleanupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicyRule) DeepCopyInto(out *FailurePolicyRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicyRule.
+func (in *FailurePolicyRule) DeepCopy() *FailurePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupJob) DeepCopyInto(out *GroupJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupJob.
+func (in *GroupJob) DeepCopy() *GroupJob {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupJobList) DeepCopyInto(out *GroupJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupJobList.
+func (in *GroupJobList) DeepCopy() *GroupJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupJobSpec) DeepCopyInto(out *GroupJobSpec) {
+	*out = *in
+	if in.SlotsPerWorker != nil {
+		in, out := &in.SlotsPerWorker, &out.SlotsPerWorker
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RunLauncherAsWorker != nil {
+		in, out := &in.RunLauncherAsWorker, &out.RunLauncherAsWorker
+		*out = new(bool)
+		**out = **in
+	}
+	in.RunPolicy.DeepCopyInto(&out.RunPolicy)
+	if in.Launcher != nil {
+		in, out := &in.Launcher, &out.Launcher
+		*out = new(ReplicaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(ReplicaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerGroups != nil {
+		in, out := &in.WorkerGroups, &out.WorkerGroups
+		*out = make([]WorkerGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SSHRunAsNonRoot != nil {
+		in, out := &in.SSHRunAsNonRoot, &out.SSHRunAsNonRoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SSHPort != nil {
+		in, out := &in.SSHPort, &out.SSHPort
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupJobSpec.
+func (in *GroupJobSpec) DeepCopy() *GroupJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobCondition) DeepCopyInto(out *JobCondition) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobCondition.
+func (in *JobCondition) DeepCopy() *JobCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(JobCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobStatus) DeepCopyInto(out *JobStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]JobCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Launcher != nil {
+		in, out := &in.Launcher, &out.Launcher
+		*out = new(ReplicaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(ReplicaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerGroups != nil {
+		in, out := &in.WorkerGroups, &out.WorkerGroups
+		*out = make([]WorkerGroupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ElasticReplicas != nil {
+		in, out := &in.ElasticReplicas, &out.ElasticReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeFailures != nil {
+		in, out := &in.NodeFailures, &out.NodeFailures
+		*out = make([]NodeFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobStatus.
+func (in *JobStatus) DeepCopy() *JobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFailure) DeepCopyInto(out *NodeFailure) {
+	*out = *in
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFailure.
+func (in *NodeFailure) DeepCopy() *NodeFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaSpec) DeepCopyInto(out *ReplicaSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SlotsPerWorker != nil {
+		in, out := &in.SlotsPerWorker, &out.SlotsPerWorker
+		*out = new(int32)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.RetryableExitCodes != nil {
+		in, out := &in.RetryableExitCodes, &out.RetryableExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaSpec.
+func (in *ReplicaSpec) DeepCopy() *ReplicaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
+	*out = *in
+	if in.FailedByCause != nil {
+		in, out := &in.FailedByCause, &out.FailedByCause
+		*out = make(map[FailureCause]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaStatus.
+func (in *ReplicaStatus) DeepCopy() *ReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunPolicy) DeepCopyInto(out *RunPolicy) {
+	*out = *in
+	if in.CleanPodPolicy != nil {
+		in, out := &in.CleanPodPolicy, &out.CleanPodPolicy
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	if in.CleanupPolicy != nil {
+		in, out := &in.CleanupPolicy, &out.CleanupPolicy
+		*out = new(CleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RestartMode != nil {
+		in, out := &in.RestartMode, &out.RestartMode
+		*out = new(RestartMode)
+		**out = **in
+	}
+	if in.NodeFailureLimit != nil {
+		in, out := &in.NodeFailureLimit, &out.NodeFailureLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = make([]FailurePolicyRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.SchedulingPolicy != nil {
+		in, out := &in.SchedulingPolicy, &out.SchedulingPolicy
+		*out = new(SchedulingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SuspendGracePeriodSeconds != nil {
+		in, out := &in.SuspendGracePeriodSeconds, &out.SuspendGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DebugHoldSeconds != nil {
+		in, out := &in.DebugHoldSeconds, &out.DebugHoldSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ManagedBy != nil {
+		in, out := &in.ManagedBy, &out.ManagedBy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunPolicy.
+func (in *RunPolicy) DeepCopy() *RunPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RunPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPolicy) DeepCopyInto(out *SchedulingPolicy) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinResources != nil {
+		in, out := &in.MinResources, &out.MinResources
+		*out = new(corev1.ResourceList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[corev1.ResourceName]resource.Quantity, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val.DeepCopy()
+			}
+		}
+	}
+	if in.ScheduleTimeoutSeconds != nil {
+		in, out := &in.ScheduleTimeoutSeconds, &out.ScheduleTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPolicy.
+func (in *SchedulingPolicy) DeepCopy() *SchedulingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupSpec) DeepCopyInto(out *WorkerGroupSpec) {
+	*out = *in
+	in.ReplicaSpec.DeepCopyInto(&out.ReplicaSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupSpec.
+func (in *WorkerGroupSpec) DeepCopy() *WorkerGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupStatus) DeepCopyInto(out *WorkerGroupStatus) {
+	*out = *in
+	in.ReplicaStatus.DeepCopyInto(&out.ReplicaStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupStatus.
+func (in *WorkerGroupStatus) DeepCopy() *WorkerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -23,6 +23,8 @@ const (
 	DefaultLauncherRestartPolicy = RestartPolicyOnFailure
 	// OperatorName is the name of the operator used as value to the label common.OperatorLabelName
 	OperatorName = "group-operator"
+	// DefaultSSHPort is the default port of sshd when it runs as non-root.
+	DefaultSSHPort int32 = 2222
 )
 
 // merge from common.v1
@@ -43,4 +45,33 @@ const (
 
 	// JobRoleLabel represents the label key for the job role, e.g. master.
 	JobRoleLabel = "training.coreweave.com/job-role"
+
+	// RestartCountAnnotation represents the annotation key for the restartCount
+	// of the GroupJob at the time the launcher Job or a worker Pod was created.
+	// It is only set when RestartMode is Group.
+	RestartCountAnnotation = "training.coreweave.com/restart-count"
+
+	// SpecHashAnnotation represents the annotation key for the hash of the
+	// replica spec the launcher Job or a worker Pod was created from.
+	SpecHashAnnotation = "training.coreweave.com/spec-hash"
+
+	// SpecChangeIgnoredAnnotation represents the annotation key for the hash
+	// of the replica spec whose change was reported as not applied to a
+	// running launcher Job or worker Pod.
+	SpecChangeIgnoredAnnotation = "training.coreweave.com/spec-change-ignored"
+
+	// SuspendRequestedAnnotation represents the annotation key set on the
+	// running pods of a GroupJob being suspended, so that they checkpoint.
+	// Its value is the time the suspension was requested.
+	SuspendRequestedAnnotation = "training.coreweave.com/suspend-requested"
+
+	// CheckpointedAnnotation represents the annotation key with which a pod
+	// acknowledges, with the value "true", that it checkpointed and can be
+	// deleted.
+	CheckpointedAnnotation = "training.coreweave.com/checkpointed"
+
+	// DebugHoldAnnotation represents the annotation key for how long the pods
+	// of a failed GroupJob are held for debugging, as a duration such as
+	// "30m". It takes precedence over RunPolicy.DebugHoldSeconds.
+	DebugHoldAnnotation = "training.coreweave.com/debug-hold"
 )
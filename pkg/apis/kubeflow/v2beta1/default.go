@@ -45,7 +45,7 @@ func setDefaultsTypeWorker(spec *ReplicaSpec) {
 		spec.RestartPolicy = DefaultRestartPolicy
 	}
 	if spec.Replicas == nil {
-		spec.Replicas = ptr.To[int32](0)
+		spec.Replicas = ptr.To(ptr.Deref(spec.MaxReplicas, 0))
 	}
 }
 
@@ -65,6 +65,15 @@ func SetDefaults_GroupJob(mpiJob *GroupJob) {
 	if mpiJob.Spec.SSHAuthMountPath == "" {
 		mpiJob.Spec.SSHAuthMountPath = "/root/.ssh"
 	}
+	if mpiJob.Spec.BootstrapMode == "" {
+		mpiJob.Spec.BootstrapMode = BootstrapModeSSH
+	}
+	if mpiJob.Spec.SSHKeyAlgorithm == "" {
+		mpiJob.Spec.SSHKeyAlgorithm = SSHKeyAlgorithmECDSA
+	}
+	if ptr.Deref(mpiJob.Spec.SSHRunAsNonRoot, false) && mpiJob.Spec.SSHPort == nil {
+		mpiJob.Spec.SSHPort = ptr.To(DefaultSSHPort)
+	}
 	if mpiJob.Spec.MPIImplementation == "" {
 		mpiJob.Spec.MPIImplementation = MPIImplementationOpenMPI
 	}
@@ -75,6 +84,10 @@ func SetDefaults_GroupJob(mpiJob *GroupJob) {
 	// set default to Launcher
 	setDefaultsTypeLauncher(mpiJob.Spec.MPIReplicaSpecs[MPIReplicaTypeLauncher])
 
-	// set default to Worker
-	setDefaultsTypeWorker(mpiJob.Spec.MPIReplicaSpecs[MPIReplicaTypeWorker])
+	// set default to Worker and the other worker groups
+	for rType, spec := range mpiJob.Spec.MPIReplicaSpecs {
+		if rType != MPIReplicaTypeLauncher {
+			setDefaultsTypeWorker(spec)
+		}
+	}
 }
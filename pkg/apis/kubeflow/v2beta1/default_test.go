@@ -35,6 +35,8 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/root/.ssh",
 					MPIImplementation:      MPIImplementationOpenMPI,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
 					LauncherCreationPolicy: "AtStartup",
 				},
 			},
@@ -51,6 +53,8 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/home/mpiuser/.ssh",
 					MPIImplementation:      MPIImplementationIntel,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "Ed25519",
 					LauncherCreationPolicy: "AtStartup",
 				},
 			},
@@ -65,6 +69,8 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/home/mpiuser/.ssh",
 					MPIImplementation:      MPIImplementationIntel,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "Ed25519",
 					LauncherCreationPolicy: "AtStartup",
 				},
 			},
@@ -81,6 +87,8 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/home/mpiuser/.ssh",
 					MPIImplementation:      MPIImplementationMPICH,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
 					LauncherCreationPolicy: "AtStartup",
 				},
 			},
@@ -95,6 +103,30 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/home/mpiuser/.ssh",
 					MPIImplementation:      MPIImplementationMPICH,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
+					LauncherCreationPolicy: "AtStartup",
+				},
+			},
+		},
+		"non-root SSH": {
+			job: GroupJob{
+				Spec: GroupJobSpec{
+					SSHRunAsNonRoot: ptr.To(true),
+				},
+			},
+			want: GroupJob{
+				Spec: GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](1),
+					RunPolicy: RunPolicy{
+						CleanPodPolicy: ptr.To(CleanPodPolicyNone),
+					},
+					SSHAuthMountPath:       "/root/.ssh",
+					MPIImplementation:      MPIImplementationOpenMPI,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
+					SSHRunAsNonRoot:        ptr.To(true),
+					SSHPort:                ptr.To[int32](2222),
 					LauncherCreationPolicy: "AtStartup",
 				},
 			},
@@ -115,6 +147,8 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/root/.ssh",
 					MPIImplementation:      MPIImplementationOpenMPI,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
 					LauncherCreationPolicy: "AtStartup",
 					MPIReplicaSpecs: map[MPIReplicaType]*ReplicaSpec{
 						MPIReplicaTypeLauncher: {
@@ -141,6 +175,8 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 					},
 					SSHAuthMountPath:       "/root/.ssh",
 					MPIImplementation:      MPIImplementationOpenMPI,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
 					LauncherCreationPolicy: "AtStartup",
 					MPIReplicaSpecs: map[MPIReplicaType]*ReplicaSpec{
 						MPIReplicaTypeWorker: {
@@ -151,6 +187,76 @@ func TestSetDefaults_GroupJob(t *testing.T) {
 				},
 			},
 		},
+		"elastic worker defaults": {
+			job: GroupJob{
+				Spec: GroupJobSpec{
+					MPIReplicaSpecs: map[MPIReplicaType]*ReplicaSpec{
+						MPIReplicaTypeWorker: {
+							MinReplicas: ptr.To[int32](2),
+							MaxReplicas: ptr.To[int32](4),
+						},
+					},
+				},
+			},
+			want: GroupJob{
+				Spec: GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](1),
+					RunPolicy: RunPolicy{
+						CleanPodPolicy: ptr.To(CleanPodPolicyNone),
+					},
+					SSHAuthMountPath:       "/root/.ssh",
+					MPIImplementation:      MPIImplementationOpenMPI,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
+					LauncherCreationPolicy: "AtStartup",
+					MPIReplicaSpecs: map[MPIReplicaType]*ReplicaSpec{
+						MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](4),
+							MinReplicas:   ptr.To[int32](2),
+							MaxReplicas:   ptr.To[int32](4),
+							RestartPolicy: DefaultRestartPolicy,
+						},
+					},
+				},
+			},
+		},
+		"worker group defaults": {
+			job: GroupJob{
+				Spec: GroupJobSpec{
+					MPIReplicaSpecs: map[MPIReplicaType]*ReplicaSpec{
+						MPIReplicaTypeLauncher: {},
+						"HighMem": {
+							Replicas:       ptr.To[int32](2),
+							SlotsPerWorker: ptr.To[int32](4),
+						},
+					},
+				},
+			},
+			want: GroupJob{
+				Spec: GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](1),
+					RunPolicy: RunPolicy{
+						CleanPodPolicy: ptr.To(CleanPodPolicyNone),
+					},
+					SSHAuthMountPath:       "/root/.ssh",
+					MPIImplementation:      MPIImplementationOpenMPI,
+					BootstrapMode:          "SSH",
+					SSHKeyAlgorithm:        "ECDSA",
+					LauncherCreationPolicy: "AtStartup",
+					MPIReplicaSpecs: map[MPIReplicaType]*ReplicaSpec{
+						MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: DefaultLauncherRestartPolicy,
+						},
+						"HighMem": {
+							Replicas:       ptr.To[int32](2),
+							SlotsPerWorker: ptr.To[int32](4),
+							RestartPolicy:  DefaultRestartPolicy,
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
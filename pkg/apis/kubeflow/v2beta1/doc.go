@@ -18,4 +18,5 @@
 
 // Package v2beta1 is the v2beta1 version of the API.
 // +groupName=coreweave.com
+// +groupGoName=Kubeflow
 package v2beta1
@@ -20,9 +20,16 @@ import (
 )
 
 // +genclient
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:scale:specpath=.spec.mpiReplicaSpecs.Worker.replicas,statuspath=.status.replicaStatuses.Worker.active,selectorpath=.status.replicaStatuses.Worker.selector
+// +kubebuilder:printcolumn:name="PodGroup",type=string,JSONPath=`.status.podGroupPhase`
+// +kubebuilder:printcolumn:name="Scheduling",type=string,JSONPath=`.status.conditions[?(@.type=="GangScheduled")].message`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type GroupJob struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -52,7 +59,7 @@ const (
 
 // SchedulingPolicy encapsulates various scheduling policies of the distributed training
 // job, for example `minAvailable` for gang-scheduling.
-// Now, it supports only for volcano and scheduler-plugins.
+// Now, it supports only for volcano, scheduler-plugins, yunikorn and kueue.
 type SchedulingPolicy struct {
 	// MinAvailable defines the minimal number of member to run the PodGroup.
 	// If the gang-scheduling isn't empty, input is passed to `.spec.minMember` in PodGroup.
@@ -66,6 +73,8 @@ type SchedulingPolicy struct {
 	// Queue defines the queue name to allocate resource for PodGroup.
 	// If the gang-scheduling is set to the volcano,
 	// input is passed to `.spec.queue` in PodGroup for the volcano,
+	// if it is set to the yunikorn or the kueue,
+	// input is passed to the queue label of the pods,
 	// and if it is set to the scheduler-plugins,
 	// input isn't passed to PodGroup.
 	// +optional
@@ -89,10 +98,41 @@ type SchedulingPolicy struct {
 	// If the gang-scheduling is set to the scheduler-plugins,
 	// input is passed to `.spec.scheduleTimeoutSeconds` in PodGroup for the scheduler-plugins,
 	// and if it is set to the volcano, input isn't passed to PodGroup.
+	// Regardless of the gang-scheduling, the controller applies the
+	// ScheduleTimeoutAction once a pod of the GroupJob stayed unscheduled for
+	// longer than this, unless it is 0.
 	// +optional
 	ScheduleTimeoutSeconds *int32 `json:"scheduleTimeoutSeconds,omitempty"`
+
+	// ScheduleTimeoutAction defines what the controller does when the
+	// scheduleTimeoutSeconds is exceeded.
+	// Options are "Fail" (default), which fails the GroupJob with the
+	// ScheduleTimeout reason, and "Requeue", which suspends the GroupJob so
+	// that it releases its resources until it is resumed.
+	// +kubebuilder:validation:Enum:=Fail;Requeue
+	// +optional
+	ScheduleTimeoutAction ScheduleTimeoutAction `json:"scheduleTimeoutAction,omitempty"`
+
+	// TopologyKey is the key of a node label, such as a rack or an NVLink
+	// domain, whose values define the topology domains of the cluster.
+	// When set, the workers prefer running in the same domain as the other
+	// workers, and the hostfile and `discover_hosts.sh` list them grouped by
+	// the domain and the node they run on, so that neighboring ranks share
+	// a switch.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
 }
 
+type ScheduleTimeoutAction string
+
+const (
+	// ScheduleTimeoutActionFail fails the GroupJob.
+	ScheduleTimeoutActionFail ScheduleTimeoutAction = "Fail"
+
+	// ScheduleTimeoutActionRequeue suspends the GroupJob.
+	ScheduleTimeoutActionRequeue ScheduleTimeoutAction = "Requeue"
+)
+
 const (
 	// KubeflowJobController represents the value of the default job controller
 	KubeflowJobController = "kubeflow.org/group-operator"
@@ -109,21 +149,56 @@ type RunPolicy struct {
 	// Default to Running.
 	CleanPodPolicy *CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
 
+	// CleanupPolicy defines what is cleaned up once the GroupJob finished,
+	// with separate choices for the workers, the launcher and the resources
+	// of the group. Unset choices follow the CleanPodPolicy.
+	// +optional
+	CleanupPolicy *CleanupPolicy `json:"cleanupPolicy,omitempty"`
+
 	// TTLSecondsAfterFinished is the TTL to clean up jobs.
-	// It may take extra ReconcilePeriod seconds for the cleanup, since
-	// reconcile gets called periodically.
+	// Once it passed since the completionTime, the GroupJob is deleted, along
+	// with the launcher Job, the workers, the ConfigMap, the Service and the
+	// Secret it owns.
 	// Default to infinite.
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
 	// Specifies the duration in seconds relative to the startTime that the job may be active
 	// before the system tries to terminate it; value must be positive integer.
+	// The deadline covers the launcher and the workers. Once it expires, the
+	// GroupJob fails with the DeadlineExceeded reason and its pods are cleaned
+	// up according to the CleanPodPolicy. The startTime is reset when the
+	// GroupJob is resumed, so the time spent suspended doesn't count.
 	// +optional
 	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 
 	// Optional number of retries before marking this job failed.
+	// It also caps the number of workers restarted after a retryable exit code,
+	// or the number of group restarts when RestartMode is Group.
 	// +optional
 	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
 
+	// RestartMode defines how the GroupJob recovers from failed pods.
+	// Options are "Pod" and "Group". Defaults to "Pod".
+	// +kubebuilder:validation:Enum:=Pod;Group
+	// +optional
+	RestartMode *RestartMode `json:"restartMode,omitempty"`
+
+	// NodeFailureLimit is the number of worker failures on a node after which
+	// the workers created again, when retried or restarted, avoid the node.
+	// Only the workers that lost their node or were evicted from it count.
+	// The failures are recorded in the nodeFailures of the status regardless.
+	// Nodes are not avoided when unset.
+	// +optional
+	NodeFailureLimit *int32 `json:"nodeFailureLimit,omitempty"`
+
+	// FailurePolicy overrides how the GroupJob reacts to failed workers
+	// depending on the cause of their failure. Causes without a rule keep the
+	// default behavior of the RestartMode and RestartPolicy.
+	// +listType=map
+	// +listMapKey=cause
+	// +optional
+	FailurePolicy []FailurePolicyRule `json:"failurePolicy,omitempty"`
+
 	// SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling
 	// +optional
 	SchedulingPolicy *SchedulingPolicy `json:"schedulingPolicy,omitempty"`
@@ -133,13 +208,37 @@ type RunPolicy struct {
 	// the GroupJob controller. If a GroupJob is suspended after creation (i.e. the
 	// flag goes from false to true), the GroupJob controller will delete all
 	// active Pods and PodGroups associated with this GroupJob. Also, it will suspend the
-	// Launcher Job. Users must design their workload to gracefully handle this.
+	// Launcher Job. Users must design their workload to gracefully handle this,
+	// for instance by checkpointing within SuspendGracePeriodSeconds.
 	// Suspending a Job will reset the StartTime field of the GroupJob.
 	//
 	// Defaults to false.
 	// +kubebuilder:default:=false
 	Suspend *bool `json:"suspend,omitempty"`
 
+	// SuspendGracePeriodSeconds is how long the running pods get to
+	// checkpoint when the GroupJob is suspended, before they are deleted.
+	// The controller first annotates the launcher and worker pods with
+	// training.coreweave.com/suspend-requested, which the pods can read
+	// through the downward API, and reports the Suspending condition. The
+	// pods are deleted as soon as one of them is annotated with
+	// training.coreweave.com/checkpointed=true, or once the period expired.
+	// Defaults to 0, which deletes the pods right away.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuspendGracePeriodSeconds *int32 `json:"suspendGracePeriodSeconds,omitempty"`
+
+	// DebugHoldSeconds is how long the pods are kept after a failure, so
+	// that they can be inspected. During the hold, the GroupJob is neither
+	// restarted nor cleaned up, including by TTLSecondsAfterFinished, and it
+	// reports the DebugHold condition with the time the hold expires. The
+	// training.coreweave.com/debug-hold annotation, with a duration such as
+	// "30m", takes precedence, and removing it releases the hold it asked for.
+	// Defaults to 0, which doesn't hold the pods.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DebugHoldSeconds *int32 `json:"debugHoldSeconds,omitempty"`
+
 	// ManagedBy is used to indicate the controller or entity that manages a GroupJob.
 	// The value must be either empty, 'kubeflow.org/group-operator' or
 	// 'kueue.x-k8s.io/multikueue'.
@@ -152,6 +251,99 @@ type RunPolicy struct {
 	ManagedBy *string `json:"managedBy,omitempty"`
 }
 
+// CleanupPolicy defines what is cleaned up once the GroupJob finished.
+type CleanupPolicy struct {
+	// Workers is the policy for the worker pods.
+	// Defaults to the CleanPodPolicy.
+	// +kubebuilder:validation:Enum:=None;Running;All
+	// +optional
+	Workers *CleanPodPolicy `json:"workers,omitempty"`
+
+	// Launcher is the policy for the launcher. Running deletes the launcher
+	// Job if it is still active, and All also deletes the pods of a finished
+	// launcher Job, along with their logs.
+	// Defaults to Running when the CleanPodPolicy is Running or All, and to
+	// None otherwise.
+	// +kubebuilder:validation:Enum:=None;Running;All
+	// +optional
+	Launcher *CleanPodPolicy `json:"launcher,omitempty"`
+
+	// Resources is the policy for the Service, the ConfigMap, the SSH Secret,
+	// the ServiceAccount, Role and RoleBinding of the Exec bootstrap mode, and
+	// the PodGroup. All deletes them, which releases the DNS records of the
+	// workers and the credentials of the launcher. None keeps them until the
+	// GroupJob is deleted.
+	// Defaults to None.
+	// +kubebuilder:validation:Enum:=None;All
+	// +optional
+	Resources *CleanPodPolicy `json:"resources,omitempty"`
+}
+
+// RestartMode describes how the GroupJob recovers from failed pods.
+type RestartMode string
+
+const (
+	// RestartModePod restarts individual pods according to the RestartPolicy
+	// of their replica. An evicted worker fails the GroupJob.
+	RestartModePod RestartMode = "Pod"
+
+	// RestartModeGroup tears down the launcher Job and all the workers when a
+	// worker fails or is evicted, or when the launcher Job fails, and then
+	// recreates the whole group. The number of group restarts is capped by
+	// RunPolicy.BackoffLimit.
+	RestartModeGroup RestartMode = "Group"
+)
+
+// FailurePolicyRule defines the action taken when a worker fails for a
+// given cause.
+type FailurePolicyRule struct {
+	// Cause is the cause of the worker failures the rule applies to.
+	// +kubebuilder:validation:Enum:=Preempted;Evicted;OOMKilled;NodeLost;Error
+	Cause FailureCause `json:"cause"`
+
+	// Action is taken when a worker fails for the cause.
+	// +kubebuilder:validation:Enum:=Restart;Fail
+	Action FailureAction `json:"action"`
+}
+
+// FailureCause classifies why a worker pod failed.
+type FailureCause string
+
+const (
+	// FailureCausePreempted means the scheduler preempted the pod in favor of
+	// a pod with a higher priority.
+	FailureCausePreempted FailureCause = "Preempted"
+
+	// FailureCauseEvicted means the pod was evicted, by the kubelet under node
+	// pressure or through the Eviction API.
+	FailureCauseEvicted FailureCause = "Evicted"
+
+	// FailureCauseOOMKilled means a container of the pod was killed for
+	// exceeding its memory limit.
+	FailureCauseOOMKilled FailureCause = "OOMKilled"
+
+	// FailureCauseNodeLost means the node of the pod became unreachable or
+	// was removed from the cluster.
+	FailureCauseNodeLost FailureCause = "NodeLost"
+
+	// FailureCauseError means a container of the pod terminated with an
+	// error, or the pod failed for any other cause.
+	FailureCauseError FailureCause = "Error"
+)
+
+// FailureAction is the action taken on a worker failure.
+type FailureAction string
+
+const (
+	// FailureActionRestart retries the failure: the failed worker is created
+	// again when RestartMode is Pod, and the group is restarted when it is
+	// Group.
+	FailureActionRestart FailureAction = "Restart"
+
+	// FailureActionFail fails the GroupJob without any restart.
+	FailureActionFail FailureAction = "Fail"
+)
+
 type LauncherCreationPolicy string
 
 const (
@@ -165,6 +357,30 @@ const (
 	LauncherCreationPolicyWaitForWorkersReady LauncherCreationPolicy = "WaitForWorkersReady"
 )
 
+type BootstrapMode string
+
+const (
+	// BootstrapModeSSH starts the processes on the workers over SSH.
+	BootstrapModeSSH BootstrapMode = "SSH"
+
+	// BootstrapModeExec starts the processes on the workers through the
+	// Kubernetes exec API.
+	BootstrapModeExec BootstrapMode = "Exec"
+)
+
+type SSHKeyAlgorithm string
+
+const (
+	// SSHKeyAlgorithmECDSA generates ECDSA keys on the P-521 curve.
+	SSHKeyAlgorithmECDSA SSHKeyAlgorithm = "ECDSA"
+
+	// SSHKeyAlgorithmEd25519 generates Ed25519 keys.
+	SSHKeyAlgorithmEd25519 SSHKeyAlgorithm = "Ed25519"
+
+	// SSHKeyAlgorithmRSA generates 4096 bits RSA keys.
+	SSHKeyAlgorithmRSA SSHKeyAlgorithm = "RSA"
+)
+
 type GroupJobSpec struct {
 
 	// Specifies the number of slots per worker used in hostfile.
@@ -184,6 +400,9 @@ type GroupJobSpec struct {
 
 	// MPIReplicaSpecs contains maps from `MPIReplicaType` to `ReplicaSpec` that
 	// specify the MPI replicas to run.
+	// Any key other than `Launcher` names a group of workers with its own
+	// template, replicas and slotsPerWorker, so that a GroupJob can mix
+	// different node types. `Worker` is the default worker group.
 	MPIReplicaSpecs map[MPIReplicaType]*ReplicaSpec `json:"mpiReplicaSpecs"`
 
 	// SSHAuthMountPath is the directory where SSH keys are mounted.
@@ -191,14 +410,58 @@ type GroupJobSpec struct {
 	// +kubebuilder:default:="/root/.ssh"
 	SSHAuthMountPath string `json:"sshAuthMountPath,omitempty"`
 
+	// BootstrapMode is how the launcher starts the processes on the workers.
+	// "SSH" (default) runs sshd on the workers and mounts a generated SSH key.
+	// "Exec" starts them with kubectl exec, which must be available in the
+	// launcher image, using a ServiceAccount created for the GroupJob that
+	// can only exec into its workers. No sshd runs and no SSH key is created.
+	// +kubebuilder:validation:Enum:=SSH;Exec
+	// +kubebuilder:default:=SSH
+	// +optional
+	BootstrapMode BootstrapMode `json:"bootstrapMode,omitempty"`
+
+	// SSHAuthSecretName is the name of an existing Secret in the namespace of
+	// the GroupJob holding the SSH keys in its "ssh-privatekey" and
+	// "ssh-publickey" entries, so that a key can be shared across GroupJobs.
+	// When set, the operator generates no SSH key, nor the host key and the
+	// known_hosts file of the workers. Only supported in the SSH bootstrap
+	// mode.
+	// +optional
+	SSHAuthSecretName string `json:"sshAuthSecretName,omitempty"`
+
+	// SSHKeyAlgorithm is the algorithm of the SSH keys generated for the
+	// GroupJob. Options are "ECDSA" (default, on the P-521 curve), "Ed25519"
+	// and "RSA" (4096 bits).
+	// +kubebuilder:validation:Enum:=ECDSA;Ed25519;RSA
+	// +kubebuilder:default:=ECDSA
+	// +optional
+	SSHKeyAlgorithm SSHKeyAlgorithm `json:"sshKeyAlgorithm,omitempty"`
+
+	// SSHRunAsNonRoot runs sshd as the user of the worker containers on
+	// SSHPort, with an sshd_config generated by the operator, instead of as
+	// root. The launcher and the workers also get the security context
+	// required by the restricted Pod Security Standard, where not set. Only
+	// supported in the SSH bootstrap mode, with the SSH keys generated by the
+	// operator.
+	// +optional
+	SSHRunAsNonRoot *bool `json:"sshRunAsNonRoot,omitempty"`
+
+	// SSHPort is the unprivileged port sshd listens on when SSHRunAsNonRoot
+	// is set. Defaults to 2222.
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	SSHPort *int32 `json:"sshPort,omitempty"`
+
 	// launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.
 	// +kubebuilder:validation:Enum:AtStartup;WaitForWorkersReady
 	// +kubebuilder:default:=AtStartup
 	LauncherCreationPolicy LauncherCreationPolicy `json:"launcherCreationPolicy,omitempty"`
 
 	// MPIImplementation is the MPI implementation.
-	// Options are "OpenMPI" (default), "Intel" and "MPICH".
-	// +kubebuilder:validation:Enum:=OpenMPI;Intel;MPICH
+	// Options are "OpenMPI" (default), "Intel", "MPICH", "MVAPICH2" and
+	// "CrayMPICH".
+	// +kubebuilder:validation:Enum:=OpenMPI;Intel;MPICH;MVAPICH2;CrayMPICH
 	// +kubebuilder:default:=OpenMPI
 	MPIImplementation MPIImplementation `json:"mpiImplementation,omitempty"`
 }
@@ -211,19 +474,27 @@ const (
 	MPIReplicaTypeLauncher MPIReplicaType = "Launcher"
 
 	// MPIReplicaTypeWorker is the type for worker replicas.
+	// It is the default worker group.
 	MPIReplicaTypeWorker MPIReplicaType = "Worker"
 )
 
 type MPIImplementation string
 
 const (
-	MPIImplementationOpenMPI MPIImplementation = "OpenMPI"
-	MPIImplementationIntel   MPIImplementation = "Intel"
-	MPIImplementationMPICH   MPIImplementation = "MPICH"
+	MPIImplementationOpenMPI   MPIImplementation = "OpenMPI"
+	MPIImplementationIntel     MPIImplementation = "Intel"
+	MPIImplementationMPICH     MPIImplementation = "MPICH"
+	MPIImplementationMVAPICH2  MPIImplementation = "MVAPICH2"
+	MPIImplementationCrayMPICH MPIImplementation = "CrayMPICH"
 )
 
 // JobStatus represents the current observed state of the training Job.
 type JobStatus struct {
+	// observedGeneration is the generation of the GroupJob that the
+	// controller last reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// conditions is a list of current observed job conditions.
 	// +optional
 	// +listType=map
@@ -252,8 +523,69 @@ type JobStatus struct {
 	// It is represented in RFC3339 form and is in UTC.
 	// +optional
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// restartCount is the number of times the launcher Job and all the workers
+	// were torn down and recreated when RestartMode is Group, or the number
+	// of failed workers that were restarted when RestartMode is Pod.
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// lastRestartTime is the last time a failed worker was counted in
+	// restartCount when RestartMode is Pod.
+	// +optional
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+
+	// elasticReplicas is the number of workers of an elastic GroupJob that
+	// are running and listed in the hostfile.
+	// +optional
+	ElasticReplicas *int32 `json:"elasticReplicas,omitempty"`
+
+	// podGroupPhase is the phase of the PodGroup of the GroupJob, when
+	// gang-scheduling is enabled, regardless of the gang-scheduler.
+	// +optional
+	PodGroupPhase PodGroupPhase `json:"podGroupPhase,omitempty"`
+
+	// nodeFailures are the nodes whose loss or evictions failed workers of
+	// the GroupJob.
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	NodeFailures []NodeFailure `json:"nodeFailures,omitempty"`
 }
 
+// NodeFailure is the failure history of the workers of a GroupJob on a node.
+type NodeFailure struct {
+	// nodeName is the name of the node.
+	NodeName string `json:"nodeName"`
+
+	// failures is the number of times workers failed on the node. Workers
+	// failing at the same time count as one failure.
+	Failures int32 `json:"failures"`
+
+	// lastFailureTime is the time the last worker failed on the node.
+	LastFailureTime metav1.Time `json:"lastFailureTime"`
+}
+
+// PodGroupPhase is the phase of the PodGroup of a GroupJob.
+type PodGroupPhase string
+
+const (
+	// PodGroupPending means the gang-scheduler didn't consider the PodGroup
+	// yet.
+	PodGroupPending PodGroupPhase = "Pending"
+
+	// PodGroupInqueue means the PodGroup was admitted by the queue of the
+	// gang-scheduler, but its pods are not bound yet.
+	PodGroupInqueue PodGroupPhase = "Inqueue"
+
+	// PodGroupRunning means the pods of the PodGroup were bound to nodes.
+	PodGroupRunning PodGroupPhase = "Running"
+
+	// PodGroupUnschedulable means the scheduler failed to find nodes for the
+	// pods of the PodGroup.
+	PodGroupUnschedulable PodGroupPhase = "Unschedulable"
+)
+
 // ReplicaStatus represents the current observed state of the replica.
 type ReplicaStatus struct {
 	// The number of actively running pods.
@@ -268,6 +600,10 @@ type ReplicaStatus struct {
 	// +optional
 	Failed int32 `json:"failed,omitempty"`
 
+	// The number of failed pods by the cause of their failure.
+	// +optional
+	FailedByCause map[FailureCause]int32 `json:"failedByCause,omitempty"`
+
 	// Deprecated: Use selector instead
 	// +optional
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
@@ -333,10 +669,30 @@ const (
 	// JobSuspended means the job has been suspended.
 	JobSuspended JobConditionType = "Suspended"
 
+	// JobSuspending means the job is being suspended, and its running pods
+	// were asked to checkpoint before they are deleted. It becomes False once
+	// they checkpointed or the grace period expired.
+	JobSuspending JobConditionType = "Suspending"
+
+	// JobDebugHold means the pods of the job are held after a failure, so
+	// that they can be inspected. Its message gives the time the hold
+	// expires. It becomes False once the hold expired or was released.
+	JobDebugHold JobConditionType = "DebugHold"
+
 	// JobFailed means one or more sub-resources (e.g. services/pods) of this job
 	// reached phase failed with no restarting.
 	// The training has failed its execution.
 	JobFailed JobConditionType = "Failed"
+
+	// JobGangScheduled means the pods of the job were bound to nodes by the
+	// gang-scheduler. While False, its reason is the phase of the PodGroup and
+	// its message is the feedback of the scheduler.
+	JobGangScheduled JobConditionType = "GangScheduled"
+
+	// JobQueued means the job waits, suspended, to be admitted by the queue
+	// of the operator. Its message gives the position of the job in the queue
+	// of its namespace. It becomes False once the job is admitted.
+	JobQueued JobConditionType = "Queued"
 )
 
 // Following is merge from common.v1
@@ -350,6 +706,25 @@ type ReplicaSpec struct {
 	// If unspecified, defaults to 1.
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// MinReplicas is the minimum number of running workers of an elastic
+	// GroupJob. The GroupJob starts once MinReplicas workers are running and
+	// keeps running as long as at least MinReplicas workers did not fail.
+	// Only valid for the Worker replica.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of workers of an elastic GroupJob.
+	// Replicas must be in the range [MinReplicas, MaxReplicas] and defaults to
+	// MaxReplicas. Only valid for the Worker replica.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// SlotsPerWorker is the number of slots per worker of this group used in
+	// the hostfile. It overrides .spec.slotsPerWorker.
+	// Not valid for the Launcher replica.
+	// +optional
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
 	// Template is the object that describes the pod that
 	// will be created for this replica. RestartPolicy in PodTemplateSpec
 	// will be overide by RestartPolicy in ReplicaSpec
@@ -359,6 +734,14 @@ type ReplicaSpec struct {
 	// One of Always, OnFailure, Never and ExitCode.
 	// Default to Never.
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// RetryableExitCodes is the set of container exit codes that are treated
+	// as retryable when RestartPolicy is ExitCode. Any other non-zero exit
+	// code is treated as a permanent error.
+	// If unspecified, exit codes 128-255 are retryable.
+	// +optional
+	// +listType=set
+	RetryableExitCodes []int32 `json:"retryableExitCodes,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -378,5 +761,7 @@ const (
 	// determine the behavior when an error occurs:
 	// - 1-127: permanent error, do not restart.
 	// - 128-255: retryable error, will restart the pod.
+	// The set of retryable exit codes can be overridden through
+	// ReplicaSpec.RetryableExitCodes.
 	RestartPolicyExitCode RestartPolicy = "ExitCode"
 )
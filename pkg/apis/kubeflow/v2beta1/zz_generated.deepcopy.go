@@ -81,6 +81,22 @@ func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 		in, out := &in.LastReconcileTime, &out.LastReconcileTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ElasticReplicas != nil {
+		in, out := &in.ElasticReplicas, &out.ElasticReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeFailures != nil {
+		in, out := &in.NodeFailures, &out.NodeFailures
+		*out = make([]NodeFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -94,6 +110,53 @@ func (in *JobStatus) DeepCopy() *JobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupPolicy) DeepCopyInto(out *CleanupPolicy) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	if in.Launcher != nil {
+		in, out := &in.Launcher, &out.Launcher
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(CleanPodPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicy.
+func (in *CleanupPolicy) DeepCopy() *CleanupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicyRule) DeepCopyInto(out *FailurePolicyRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicyRule.
+func (in *FailurePolicyRule) DeepCopy() *FailurePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupJob) DeepCopyInto(out *GroupJob) {
 	*out = *in
@@ -184,6 +247,16 @@ func (in *GroupJobSpec) DeepCopyInto(out *GroupJobSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.SSHRunAsNonRoot != nil {
+		in, out := &in.SSHRunAsNonRoot, &out.SSHRunAsNonRoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SSHPort != nil {
+		in, out := &in.SSHPort, &out.SSHPort
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -197,6 +270,23 @@ func (in *GroupJobSpec) DeepCopy() *GroupJobSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFailure) DeepCopyInto(out *NodeFailure) {
+	*out = *in
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFailure.
+func (in *NodeFailure) DeepCopy() *NodeFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaSpec) DeepCopyInto(out *ReplicaSpec) {
 	*out = *in
@@ -205,7 +295,27 @@ func (in *ReplicaSpec) DeepCopyInto(out *ReplicaSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SlotsPerWorker != nil {
+		in, out := &in.SlotsPerWorker, &out.SlotsPerWorker
+		*out = new(int32)
+		**out = **in
+	}
 	in.Template.DeepCopyInto(&out.Template)
+	if in.RetryableExitCodes != nil {
+		in, out := &in.RetryableExitCodes, &out.RetryableExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -222,6 +332,13 @@ func (in *ReplicaSpec) DeepCopy() *ReplicaSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
 	*out = *in
+	if in.FailedByCause != nil {
+		in, out := &in.FailedByCause, &out.FailedByCause
+		*out = make(map[FailureCause]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(v1.LabelSelector)
@@ -248,6 +365,11 @@ func (in *RunPolicy) DeepCopyInto(out *RunPolicy) {
 		*out = new(CleanPodPolicy)
 		**out = **in
 	}
+	if in.CleanupPolicy != nil {
+		in, out := &in.CleanupPolicy, &out.CleanupPolicy
+		*out = new(CleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TTLSecondsAfterFinished != nil {
 		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
 		*out = new(int32)
@@ -263,6 +385,21 @@ func (in *RunPolicy) DeepCopyInto(out *RunPolicy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RestartMode != nil {
+		in, out := &in.RestartMode, &out.RestartMode
+		*out = new(RestartMode)
+		**out = **in
+	}
+	if in.NodeFailureLimit != nil {
+		in, out := &in.NodeFailureLimit, &out.NodeFailureLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = make([]FailurePolicyRule, len(*in))
+		copy(*out, *in)
+	}
 	if in.SchedulingPolicy != nil {
 		in, out := &in.SchedulingPolicy, &out.SchedulingPolicy
 		*out = new(SchedulingPolicy)
@@ -273,6 +410,16 @@ func (in *RunPolicy) DeepCopyInto(out *RunPolicy) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SuspendGracePeriodSeconds != nil {
+		in, out := &in.SuspendGracePeriodSeconds, &out.SuspendGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DebugHoldSeconds != nil {
+		in, out := &in.DebugHoldSeconds, &out.DebugHoldSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ManagedBy != nil {
 		in, out := &in.ManagedBy, &out.ManagedBy
 		*out = new(string)
@@ -27,195 +27,135 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobCondition":     schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobStatus":        schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJob":         schema_pkg_apis_kubeflow_v2beta1_GroupJob(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJobList":     schema_pkg_apis_kubeflow_v2beta1_GroupJobList(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJobSpec":     schema_pkg_apis_kubeflow_v2beta1_GroupJobSpec(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec":      schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus":    schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.RunPolicy":        schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref),
-		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy": schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                  schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                              schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                               schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                           schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                               schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                              schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                 schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                             schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                             schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                  schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                  schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                  schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                 schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                             schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                              schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                             schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                             schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                      schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                  schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                               schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                        schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                 schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                            schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                     schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                 schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                     schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                              schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                             schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                 schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                 schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                    schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                               schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                             schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                     schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                     schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                              schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                  schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                         schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                      schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                 schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                  schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                             schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                           schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.CleanupPolicy":     schema_pkg_apis_kubeflow_v2beta1_CleanupPolicy(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.FailurePolicyRule": schema_pkg_apis_kubeflow_v2beta1_FailurePolicyRule(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJob":          schema_pkg_apis_kubeflow_v2beta1_GroupJob(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJobList":      schema_pkg_apis_kubeflow_v2beta1_GroupJobList(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJobSpec":      schema_pkg_apis_kubeflow_v2beta1_GroupJobSpec(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobCondition":      schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobStatus":         schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.NodeFailure":       schema_pkg_apis_kubeflow_v2beta1_NodeFailure(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec":       schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus":     schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.RunPolicy":         schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref),
+		"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy":  schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                   schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                               schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                            schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                               schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                  schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                              schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                              schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                   schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                   schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                   schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                 schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                  schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                              schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                               schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                   schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                           schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                       schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                              schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                              schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                   schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                       schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                   schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                         schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                  schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                 schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                             schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                      schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                  schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                      schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                               schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                              schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                  schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                  schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                     schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                              schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                      schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                      schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                               schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                   schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                          schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                       schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                  schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                   schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                              schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                 schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                    schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                        schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                         schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                            schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_CleanupPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JobCondition describes the state of the job at a certain point.",
+				Description: "CleanupPolicy defines what is cleaned up once the GroupJob finished.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type of job condition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "Workers is the policy for the worker pods. Defaults to the CleanPodPolicy.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"launcher": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Launcher is the policy for the launcher. Running deletes the launcher Job if it is still active, and All also deletes the pods of a finished launcher Job, along with their logs. Defaults to Running when the CleanPodPolicy is Running or All, and to None otherwise.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human-readable message indicating details about the transition.",
+							Description: "Resources is the policy for the Service, the ConfigMap, the SSH Secret, the ServiceAccount, Role and RoleBinding of the Exec bootstrap mode, and the PodGroup. All deletes them, which releases the DNS records of the workers and the credentials of the launcher. None keeps them until the GroupJob is deleted. Defaults to None.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The last time this condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_FailurePolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JobStatus represents the current observed state of the training Job.",
+				Description: "FailurePolicyRule defines the action taken when a worker fails for a given cause.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
-						SchemaProps: spec.SchemaProps{
-							Description: "conditions is a list of current observed job conditions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobCondition"),
-									},
-								},
-							},
-						},
-					},
-					"replicaStatuses": {
-						SchemaProps: spec.SchemaProps{
-							Description: "replicaStatuses is map of ReplicaType and ReplicaStatus, specifies the status of each replica.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus"),
-									},
-								},
-							},
-						},
-					},
-					"startTime": {
+					"cause": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents time when the job was acknowledged by the job controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"completionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Represents time when the job was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Cause is the cause of the worker failures the rule applies to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents last time when the job was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Action is taken when a worker fails for the cause.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"cause", "action"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobCondition", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -261,7 +201,7 @@ func schema_pkg_apis_kubeflow_v2beta1_GroupJob(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobStatus", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.GroupJobSpec", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -342,7 +282,7 @@ func schema_pkg_apis_kubeflow_v2beta1_GroupJobSpec(ref common.ReferenceCallback)
 					},
 					"mpiReplicaSpecs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MPIReplicaSpecs contains maps from `MPIReplicaType` to `ReplicaSpec` that specify the MPI replicas to run.",
+							Description: "MPIReplicaSpecs contains maps from `MPIReplicaType` to `ReplicaSpec` that specify the MPI replicas to run. Any key other than `Launcher` names a group of workers with its own template, replicas and slotsPerWorker, so that a GroupJob can mix different node types. `Worker` is the default worker group.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -361,6 +301,41 @@ func schema_pkg_apis_kubeflow_v2beta1_GroupJobSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"bootstrapMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BootstrapMode is how the launcher starts the processes on the workers. \"SSH\" (default) runs sshd on the workers and mounts a generated SSH key. \"Exec\" starts them with kubectl exec, which must be available in the launcher image, using a ServiceAccount created for the GroupJob that can only exec into its workers. No sshd runs and no SSH key is created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshAuthSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHAuthSecretName is the name of an existing Secret in the namespace of the GroupJob holding the SSH keys in its \"ssh-privatekey\" and \"ssh-publickey\" entries, so that a key can be shared across GroupJobs. When set, the operator generates no SSH key, nor the host key and the known_hosts file of the workers. Only supported in the SSH bootstrap mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshKeyAlgorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeyAlgorithm is the algorithm of the SSH keys generated for the GroupJob. Options are \"ECDSA\" (default, on the P-521 curve), \"Ed25519\" and \"RSA\" (4096 bits).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshRunAsNonRoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHRunAsNonRoot runs sshd as the user of the worker containers on SSHPort, with an sshd_config generated by the operator, instead of as root. The launcher and the workers also get the security context required by the restricted Pod Security Standard, where not set. Only supported in the SSH bootstrap mode, with the SSH keys generated by the operator.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sshPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHPort is the unprivileged port sshd listens on when SSHRunAsNonRoot is set. Defaults to 2222.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"launcherCreationPolicy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.",
@@ -370,7 +345,7 @@ func schema_pkg_apis_kubeflow_v2beta1_GroupJobSpec(ref common.ReferenceCallback)
 					},
 					"mpiImplementation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MPIImplementation is the MPI implementation. Options are \"OpenMPI\" (default), \"Intel\" and \"MPICH\".",
+							Description: "MPIImplementation is the MPI implementation. Options are \"OpenMPI\" (default), \"Intel\", \"MPICH\", \"MVAPICH2\" and \"CrayMPICH\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -384,6 +359,227 @@ func schema_pkg_apis_kubeflow_v2beta1_GroupJobSpec(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobCondition describes the state of the job at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of job condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human-readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time this condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobStatus represents the current observed state of the training Job.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the generation of the GroupJob that the controller last reconciled.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions is a list of current observed job conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobCondition"),
+									},
+								},
+							},
+						},
+					},
+					"replicaStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicaStatuses is map of ReplicaType and ReplicaStatus, specifies the status of each replica.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus"),
+									},
+								},
+							},
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the job was acknowledged by the job controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the job was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents last time when the job was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restartCount is the number of times the launcher Job and all the workers were torn down and recreated when RestartMode is Group, or the number of failed workers that were restarted when RestartMode is Pod.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastRestartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastRestartTime is the last time a failed worker was counted in restartCount when RestartMode is Pod.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"elasticReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "elasticReplicas is the number of workers of an elastic GroupJob that are running and listed in the hostfile.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podGroupPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "podGroupPhase is the phase of the PodGroup of the GroupJob, when gang-scheduling is enabled, regardless of the gang-scheduler.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeFailures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"nodeName",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeFailures are the nodes whose loss or evictions failed workers of the GroupJob.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.NodeFailure"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.JobCondition", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.NodeFailure", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_NodeFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeFailure is the failure history of the workers of a GroupJob on a node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeName is the name of the node.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failures is the number of times workers failed on the node. Workers failing at the same time count as one failure.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastFailureTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastFailureTime is the time the last worker failed on the node.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"nodeName", "failures", "lastFailureTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -398,6 +594,27 @@ func schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref common.ReferenceCallback)
 							Format:      "int32",
 						},
 					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of running workers of an elastic GroupJob. The GroupJob starts once MinReplicas workers are running and keeps running as long as at least MinReplicas workers did not fail. Only valid for the Worker replica.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of workers of an elastic GroupJob. Replicas must be in the range [MinReplicas, MaxReplicas] and defaults to MaxReplicas. Only valid for the Worker replica.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"slotsPerWorker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SlotsPerWorker is the number of slots per worker of this group used in the hostfile. It overrides .spec.slotsPerWorker. Not valid for the Launcher replica.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"template": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Template is the object that describes the pod that will be created for this replica. RestartPolicy in PodTemplateSpec will be overide by RestartPolicy in ReplicaSpec",
@@ -412,6 +629,26 @@ func schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"retryableExitCodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryableExitCodes is the set of container exit codes that are treated as retryable when RestartPolicy is ExitCode. Any other non-zero exit code is treated as a permanent error. If unspecified, exit codes 128-255 are retryable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -448,6 +685,22 @@ func schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref common.ReferenceCallback
 							Format:      "int32",
 						},
 					},
+					"failedByCause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of failed pods by the cause of their failure.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Deprecated: Use selector instead",
@@ -483,27 +736,69 @@ func schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"cleanupPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanupPolicy defines what is cleaned up once the GroupJob finished, with separate choices for the workers, the launcher and the resources of the group. Unset choices follow the CleanPodPolicy.",
+							Ref:         ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.CleanupPolicy"),
+						},
+					},
 					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished is the TTL to clean up jobs. It may take extra ReconcilePeriod seconds for the cleanup, since reconcile gets called periodically. Default to infinite.",
+							Description: "TTLSecondsAfterFinished is the TTL to clean up jobs. Once it passed since the completionTime, the GroupJob is deleted, along with the launcher Job, the workers, the ConfigMap, the Service and the Secret it owns. Default to infinite.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
 					"activeDeadlineSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the duration in seconds relative to the startTime that the job may be active before the system tries to terminate it; value must be positive integer.",
+							Description: "Specifies the duration in seconds relative to the startTime that the job may be active before the system tries to terminate it; value must be positive integer. The deadline covers the launcher and the workers. Once it expires, the GroupJob fails with the DeadlineExceeded reason and its pods are cleaned up according to the CleanPodPolicy. The startTime is reset when the GroupJob is resumed, so the time spent suspended doesn't count.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional number of retries before marking this job failed.",
+							Description: "Optional number of retries before marking this job failed. It also caps the number of workers restarted after a retryable exit code, or the number of group restarts when RestartMode is Group.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"restartMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartMode defines how the GroupJob recovers from failed pods. Options are \"Pod\" and \"Group\". Defaults to \"Pod\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeFailureLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeFailureLimit is the number of worker failures on a node after which the workers created again, when retried or restarted, avoid the node. Only the workers that lost their node or were evicted from it count. The failures are recorded in the nodeFailures of the status regardless. Nodes are not avoided when unset.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failurePolicy": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"cause",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy overrides how the GroupJob reacts to failed workers depending on the cause of their failure. Causes without a rule keep the default behavior of the RestartMode and RestartPolicy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.FailurePolicyRule"),
+									},
+								},
+							},
+						},
+					},
 					"schedulingPolicy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling",
@@ -512,11 +807,25 @@ func schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref common.ReferenceCallback) co
 					},
 					"suspend": {
 						SchemaProps: spec.SchemaProps{
-							Description: "suspend specifies whether the GroupJob controller should create Pods or not. If a GroupJob is created with suspend set to true, no Pods are created by the GroupJob controller. If a GroupJob is suspended after creation (i.e. the flag goes from false to true), the GroupJob controller will delete all active Pods and PodGroups associated with this GroupJob. Also, it will suspend the Launcher Job. Users must design their workload to gracefully handle this. Suspending a Job will reset the StartTime field of the GroupJob.\n\nDefaults to false.",
+							Description: "suspend specifies whether the GroupJob controller should create Pods or not. If a GroupJob is created with suspend set to true, no Pods are created by the GroupJob controller. If a GroupJob is suspended after creation (i.e. the flag goes from false to true), the GroupJob controller will delete all active Pods and PodGroups associated with this GroupJob. Also, it will suspend the Launcher Job. Users must design their workload to gracefully handle this, for instance by checkpointing within SuspendGracePeriodSeconds. Suspending a Job will reset the StartTime field of the GroupJob.\n\nDefaults to false.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"suspendGracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendGracePeriodSeconds is how long the running pods get to checkpoint when the GroupJob is suspended, before they are deleted. The controller first annotates the launcher and worker pods with training.coreweave.com/suspend-requested, which the pods can read through the downward API, and reports the Suspending condition. The pods are deleted as soon as one of them is annotated with training.coreweave.com/checkpointed=true, or once the period expired. Defaults to 0, which deletes the pods right away.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"debugHoldSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugHoldSeconds is how long the pods are kept after a failure, so that they can be inspected. During the hold, the GroupJob is neither restarted nor cleaned up, including by TTLSecondsAfterFinished, and it reports the DebugHold condition with the time the hold expires. The training.coreweave.com/debug-hold annotation, with a duration such as \"30m\", takes precedence, and removing it releases the hold it asked for. Defaults to 0, which doesn't hold the pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"managedBy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ManagedBy is used to indicate the controller or entity that manages a GroupJob. The value must be either empty, 'kubeflow.org/group-operator' or 'kueue.x-k8s.io/multikueue'. The group-operator reconciles a GroupJob which doesn't have this field at all or the field value is the reserved string 'kubeflow.org/group-operator', but delegates reconciling the GroupJob with 'kueue.x-k8s.io/multikueue' to the Kueue. The field is immutable.",
@@ -528,7 +837,7 @@ func schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy"},
+			"github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.CleanupPolicy", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.FailurePolicyRule", "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy"},
 	}
 }
 
@@ -536,7 +845,7 @@ func schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref common.ReferenceCallb
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SchedulingPolicy encapsulates various scheduling policies of the distributed training job, for example `minAvailable` for gang-scheduling. Now, it supports only for volcano and scheduler-plugins.",
+				Description: "SchedulingPolicy encapsulates various scheduling policies of the distributed training job, for example `minAvailable` for gang-scheduling. Now, it supports only for volcano, scheduler-plugins, yunikorn and kueue.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"minAvailable": {
@@ -548,7 +857,7 @@ func schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref common.ReferenceCallb
 					},
 					"queue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Queue defines the queue name to allocate resource for PodGroup. If the gang-scheduling is set to the volcano, input is passed to `.spec.queue` in PodGroup for the volcano, and if it is set to the scheduler-plugins, input isn't passed to PodGroup.",
+							Description: "Queue defines the queue name to allocate resource for PodGroup. If the gang-scheduling is set to the volcano, input is passed to `.spec.queue` in PodGroup for the volcano, if it is set to the yunikorn or the kueue, input is passed to the queue label of the pods, and if it is set to the scheduler-plugins, input isn't passed to PodGroup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -576,11 +885,25 @@ func schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref common.ReferenceCallb
 					},
 					"scheduleTimeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerTimeoutSeconds defines the maximal time of members to wait before run the PodGroup. If the gang-scheduling is set to the scheduler-plugins, input is passed to `.spec.scheduleTimeoutSeconds` in PodGroup for the scheduler-plugins, and if it is set to the volcano, input isn't passed to PodGroup.",
+							Description: "SchedulerTimeoutSeconds defines the maximal time of members to wait before run the PodGroup. If the gang-scheduling is set to the scheduler-plugins, input is passed to `.spec.scheduleTimeoutSeconds` in PodGroup for the scheduler-plugins, and if it is set to the volcano, input isn't passed to PodGroup. Regardless of the gang-scheduling, the controller applies the ScheduleTimeoutAction once a pod of the GroupJob stayed unscheduled for longer than this, unless it is 0.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"scheduleTimeoutAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduleTimeoutAction defines what the controller does when the scheduleTimeoutSeconds is exceeded. Options are \"Fail\" (default), which fails the GroupJob with the ScheduleTimeout reason, and \"Requeue\", which suspends the GroupJob so that it releases its resources until it is resumed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of a node label, such as a rack or an NVLink domain, whose values define the topology domains of the cluster. When set, the workers prefer running in the same domain as the other workers, and the hostfile and `discover_hosts.sh` list them grouped by the domain and the node they run on, so that neighboring ranks share a switch.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
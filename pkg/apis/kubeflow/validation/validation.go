@@ -16,12 +16,16 @@ package validation
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 )
@@ -32,41 +36,159 @@ var (
 		string(kubeflow.CleanPodPolicyRunning),
 		string(kubeflow.CleanPodPolicyAll))
 
+	validCleanResourcesPolicies = sets.NewString(
+		string(kubeflow.CleanPodPolicyNone),
+		string(kubeflow.CleanPodPolicyAll))
+
 	validMPIImplementations = sets.NewString(
 		string(kubeflow.MPIImplementationOpenMPI),
 		string(kubeflow.MPIImplementationIntel),
-		string(kubeflow.MPIImplementationMPICH))
+		string(kubeflow.MPIImplementationMPICH),
+		string(kubeflow.MPIImplementationMVAPICH2),
+		string(kubeflow.MPIImplementationCrayMPICH))
+
+	validBootstrapModes = sets.NewString(
+		string(kubeflow.BootstrapModeSSH),
+		string(kubeflow.BootstrapModeExec))
+
+	validSSHKeyAlgorithms = sets.NewString(
+		string(kubeflow.SSHKeyAlgorithmECDSA),
+		string(kubeflow.SSHKeyAlgorithmEd25519),
+		string(kubeflow.SSHKeyAlgorithmRSA))
+
+	// sshAuthSecretKeys are the entries a Secret referenced by
+	// spec.sshAuthSecretName must have.
+	sshAuthSecretKeys = []string{corev1.SSHAuthPrivateKey, "ssh-publickey"}
 
 	validRestartPolicies = sets.NewString(
 		string(kubeflow.RestartPolicyNever),
-		string(kubeflow.RestartPolicyOnFailure))
+		string(kubeflow.RestartPolicyOnFailure),
+		string(kubeflow.RestartPolicyExitCode))
+
+	validRestartModes = sets.NewString(
+		string(kubeflow.RestartModePod),
+		string(kubeflow.RestartModeGroup))
+
+	validFailureCauses = sets.NewString(
+		string(kubeflow.FailureCausePreempted),
+		string(kubeflow.FailureCauseEvicted),
+		string(kubeflow.FailureCauseOOMKilled),
+		string(kubeflow.FailureCauseNodeLost),
+		string(kubeflow.FailureCauseError))
+
+	validFailureActions = sets.NewString(
+		string(kubeflow.FailureActionRestart),
+		string(kubeflow.FailureActionFail))
+
+	validScheduleTimeoutActions = sets.NewString(
+		string(kubeflow.ScheduleTimeoutActionFail),
+		string(kubeflow.ScheduleTimeoutActionRequeue))
 
 	validManagedBy = sets.NewString(
 		string(kubeflow.MultiKueueController),
 		string(kubeflow.KubeflowJobController))
 )
 
+// maxRetryableExitCodes leaves room for the exit code 0 in the launcher Job's
+// podFailurePolicy, which accepts at most 255 values per rule.
+const maxRetryableExitCodes = 254
+
 func ValidateGroupJob(job *kubeflow.GroupJob) field.ErrorList {
 	errs := validateGroupJobName(job)
+	errs = append(errs, validateDebugHoldAnnotation(job)...)
 	errs = append(errs, validateGroupJobSpec(&job.Spec, field.NewPath("spec"))...)
 	return errs
 }
 
+// ValidateGroupJobUpdate validates an update of a GroupJob. On top of the
+// validation of the new GroupJob, the replica templates, the set of replica
+// types, the MPI implementation, the bootstrap mode and the SSH keys are
+// immutable while the GroupJob is running.
+func ValidateGroupJobUpdate(oldJob, job *kubeflow.GroupJob) field.ErrorList {
+	errs := ValidateGroupJob(job)
+	if !isRunning(oldJob) {
+		return errs
+	}
+	specPath := field.NewPath("spec")
+	errs = append(errs, apivalidation.ValidateImmutableField(job.Spec.MPIImplementation, oldJob.Spec.MPIImplementation, specPath.Child("mpiImplementation"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(job.Spec.BootstrapMode, oldJob.Spec.BootstrapMode, specPath.Child("bootstrapMode"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(job.Spec.SSHAuthSecretName, oldJob.Spec.SSHAuthSecretName, specPath.Child("sshAuthSecretName"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(job.Spec.SSHKeyAlgorithm, oldJob.Spec.SSHKeyAlgorithm, specPath.Child("sshKeyAlgorithm"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(job.Spec.SSHRunAsNonRoot, oldJob.Spec.SSHRunAsNonRoot, specPath.Child("sshRunAsNonRoot"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(job.Spec.SSHPort, oldJob.Spec.SSHPort, specPath.Child("sshPort"))...)
+	replicasPath := specPath.Child("mpiReplicaSpecs")
+	for _, rType := range sortedReplicaTypes(job.Spec.MPIReplicaSpecs) {
+		oldSpec, ok := oldJob.Spec.MPIReplicaSpecs[rType]
+		if !ok {
+			errs = append(errs, field.Forbidden(replicasPath.Key(string(rType)), "cannot be added while the GroupJob is running"))
+			continue
+		}
+		if spec := job.Spec.MPIReplicaSpecs[rType]; spec != nil && oldSpec != nil {
+			errs = append(errs, apivalidation.ValidateImmutableField(spec.Template, oldSpec.Template, replicasPath.Key(string(rType)).Child("template"))...)
+		}
+	}
+	for _, rType := range sortedReplicaTypes(oldJob.Spec.MPIReplicaSpecs) {
+		if _, ok := job.Spec.MPIReplicaSpecs[rType]; !ok {
+			errs = append(errs, field.Forbidden(replicasPath.Key(string(rType)), "cannot be removed while the GroupJob is running"))
+		}
+	}
+	return errs
+}
+
+// isRunning returns whether the GroupJob started and is neither suspended
+// nor finished.
+func isRunning(job *kubeflow.GroupJob) bool {
+	if job.Status.StartTime == nil || ptr.Deref(job.Spec.RunPolicy.Suspend, false) {
+		return false
+	}
+	for _, c := range job.Status.Conditions {
+		if (c.Type == kubeflow.JobSucceeded || c.Type == kubeflow.JobFailed) && c.Status == corev1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
 func validateGroupJobName(job *kubeflow.GroupJob) field.ErrorList {
 	var allErrs field.ErrorList
-	var replicas int32 = 1
-	if workerSpec := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; workerSpec != nil {
-		if workerSpec.Replicas != nil && *workerSpec.Replicas > 0 {
-			replicas = *workerSpec.Replicas
+	groups := []kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeWorker}
+	for _, rType := range sortedReplicaTypes(job.Spec.MPIReplicaSpecs) {
+		// Invalid group names are reported by validateMPIReplicaSpecs.
+		name := strings.ToLower(string(rType))
+		if rType != kubeflow.MPIReplicaTypeLauncher && rType != kubeflow.MPIReplicaTypeWorker && len(apimachineryvalidation.IsDNS1035Label(name)) == 0 {
+			groups = append(groups, rType)
 		}
 	}
-	maximumPodHostname := fmt.Sprintf("%s-worker-%d", job.Name, replicas-1)
-	if errs := apimachineryvalidation.IsDNS1035Label(maximumPodHostname); len(errs) > 0 {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata").Child("name"), job.ObjectMeta.Name, fmt.Sprintf("will not able to create pod and service with invalid DNS label %q: %s", maximumPodHostname, strings.Join(errs, ", "))))
+	for _, rType := range groups {
+		var replicas int32 = 1
+		if spec := job.Spec.MPIReplicaSpecs[rType]; spec != nil {
+			if spec.Replicas != nil && *spec.Replicas > 0 {
+				replicas = *spec.Replicas
+			}
+		}
+		maximumPodHostname := fmt.Sprintf("%s-%s-%d", job.Name, strings.ToLower(string(rType)), replicas-1)
+		if errs := apimachineryvalidation.IsDNS1035Label(maximumPodHostname); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metadata").Child("name"), job.ObjectMeta.Name, fmt.Sprintf("will not able to create pod and service with invalid DNS label %q: %s", maximumPodHostname, strings.Join(errs, ", "))))
+			break
+		}
 	}
 	return allErrs
 }
 
+// validateDebugHoldAnnotation validates that the debug hold annotation, if
+// any, is a non-negative duration.
+func validateDebugHoldAnnotation(job *kubeflow.GroupJob) field.ErrorList {
+	value, ok := job.Annotations[kubeflow.DebugHoldAnnotation]
+	if !ok {
+		return nil
+	}
+	path := field.NewPath("metadata", "annotations").Key(kubeflow.DebugHoldAnnotation)
+	if d, err := time.ParseDuration(value); err != nil || d < 0 {
+		return field.ErrorList{field.Invalid(path, value, "must be a non-negative duration, such as 30m")}
+	}
+	return nil
+}
+
 func validateGroupJobSpec(spec *kubeflow.GroupJobSpec, path *field.Path) field.ErrorList {
 	errs := validateMPIReplicaSpecs(spec.MPIReplicaSpecs, path.Child("mpiReplicaSpecs"))
 	if spec.SlotsPerWorker == nil {
@@ -81,6 +203,57 @@ func validateGroupJobSpec(spec *kubeflow.GroupJobSpec, path *field.Path) field.E
 	if !validMPIImplementations.Has(string(spec.MPIImplementation)) {
 		errs = append(errs, field.NotSupported(path.Child("mpiImplementation"), spec.MPIImplementation, validMPIImplementations.List()))
 	}
+	// An empty bootstrapMode means SSH.
+	if spec.BootstrapMode != "" && !validBootstrapModes.Has(string(spec.BootstrapMode)) {
+		errs = append(errs, field.NotSupported(path.Child("bootstrapMode"), spec.BootstrapMode, validBootstrapModes.List()))
+	}
+	if spec.SSHAuthSecretName != "" {
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(spec.SSHAuthSecretName) {
+			errs = append(errs, field.Invalid(path.Child("sshAuthSecretName"), spec.SSHAuthSecretName, msg))
+		}
+		if spec.BootstrapMode == kubeflow.BootstrapModeExec {
+			errs = append(errs, field.Forbidden(path.Child("sshAuthSecretName"), "only supported in the SSH bootstrap mode"))
+		}
+	}
+	// An empty sshKeyAlgorithm means ECDSA.
+	if spec.SSHKeyAlgorithm != "" && !validSSHKeyAlgorithms.Has(string(spec.SSHKeyAlgorithm)) {
+		errs = append(errs, field.NotSupported(path.Child("sshKeyAlgorithm"), spec.SSHKeyAlgorithm, validSSHKeyAlgorithms.List()))
+	}
+	errs = append(errs, validateSSHRunAsNonRoot(spec, path)...)
+	return errs
+}
+
+func validateSSHRunAsNonRoot(spec *kubeflow.GroupJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if !ptr.Deref(spec.SSHRunAsNonRoot, false) {
+		if spec.SSHPort != nil {
+			errs = append(errs, field.Forbidden(path.Child("sshPort"), "only used when sshRunAsNonRoot is set"))
+		}
+		return errs
+	}
+	if spec.BootstrapMode == kubeflow.BootstrapModeExec {
+		errs = append(errs, field.Forbidden(path.Child("sshRunAsNonRoot"), "only supported in the SSH bootstrap mode"))
+	}
+	// sshd needs the host key the operator generates.
+	if spec.SSHAuthSecretName != "" {
+		errs = append(errs, field.Forbidden(path.Child("sshRunAsNonRoot"), "not supported with sshAuthSecretName"))
+	}
+	if spec.SSHPort != nil && (*spec.SSHPort < 1024 || *spec.SSHPort > 65535) {
+		errs = append(errs, field.Invalid(path.Child("sshPort"), *spec.SSHPort, "must be an unprivileged port between 1024 and 65535"))
+	}
+	return errs
+}
+
+// ValidateSSHAuthSecret validates that the Secret referenced by
+// spec.sshAuthSecretName of job holds the SSH keys.
+func ValidateSSHAuthSecret(job *kubeflow.GroupJob, secret *corev1.Secret) field.ErrorList {
+	var errs field.ErrorList
+	path := field.NewPath("spec").Child("sshAuthSecretName")
+	for _, key := range sshAuthSecretKeys {
+		if len(secret.Data[key]) == 0 {
+			errs = append(errs, field.Invalid(path, job.Spec.SSHAuthSecretName, fmt.Sprintf("Secret has no %q entry", key)))
+		}
+	}
 	return errs
 }
 
@@ -92,20 +265,87 @@ func validateRunPolicy(policy *kubeflow.RunPolicy, path *field.Path) field.Error
 		errs = append(errs, field.NotSupported(path.Child("cleanPodPolicy"), *policy.CleanPodPolicy, validCleanPolicies.List()))
 	}
 	// The remaining fields can be nil.
+	if policy.CleanupPolicy != nil {
+		errs = append(errs, validateCleanupPolicy(policy.CleanupPolicy, path.Child("cleanupPolicy"))...)
+	}
 	if policy.TTLSecondsAfterFinished != nil {
 		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*policy.TTLSecondsAfterFinished), path.Child("ttlSecondsAfterFinished"))...)
 	}
+	if policy.SuspendGracePeriodSeconds != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*policy.SuspendGracePeriodSeconds), path.Child("suspendGracePeriodSeconds"))...)
+	}
+	if policy.DebugHoldSeconds != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*policy.DebugHoldSeconds), path.Child("debugHoldSeconds"))...)
+	}
 	if policy.ActiveDeadlineSeconds != nil {
 		errs = append(errs, apivalidation.ValidateNonnegativeField(*policy.ActiveDeadlineSeconds, path.Child("activeDeadlineSeconds"))...)
 	}
 	if policy.BackoffLimit != nil {
 		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*policy.BackoffLimit), path.Child("backoffLimit"))...)
 	}
+	if policy.RestartMode != nil && !validRestartModes.Has(string(*policy.RestartMode)) {
+		errs = append(errs, field.NotSupported(path.Child("restartMode"), *policy.RestartMode, validRestartModes.List()))
+	}
+	if policy.NodeFailureLimit != nil && *policy.NodeFailureLimit <= 0 {
+		errs = append(errs, field.Invalid(path.Child("nodeFailureLimit"), *policy.NodeFailureLimit, "must be greater than 0"))
+	}
+	errs = append(errs, validateFailurePolicy(policy.FailurePolicy, path.Child("failurePolicy"))...)
 	if policy.ManagedBy != nil {
 		if !validManagedBy.Has(*policy.ManagedBy) {
 			errs = append(errs, field.NotSupported(path.Child("managedBy"), *policy.ManagedBy, validManagedBy.List()))
 		}
 	}
+	if policy.SchedulingPolicy != nil {
+		errs = append(errs, validateSchedulingPolicy(policy.SchedulingPolicy, path.Child("schedulingPolicy"))...)
+	}
+	return errs
+}
+
+func validateCleanupPolicy(policy *kubeflow.CleanupPolicy, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if policy.Workers != nil && !validCleanPolicies.Has(string(*policy.Workers)) {
+		errs = append(errs, field.NotSupported(path.Child("workers"), *policy.Workers, validCleanPolicies.List()))
+	}
+	if policy.Launcher != nil && !validCleanPolicies.Has(string(*policy.Launcher)) {
+		errs = append(errs, field.NotSupported(path.Child("launcher"), *policy.Launcher, validCleanPolicies.List()))
+	}
+	if policy.Resources != nil && !validCleanResourcesPolicies.Has(string(*policy.Resources)) {
+		errs = append(errs, field.NotSupported(path.Child("resources"), *policy.Resources, validCleanResourcesPolicies.List()))
+	}
+	return errs
+}
+
+func validateFailurePolicy(rules []kubeflow.FailurePolicyRule, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	seen := sets.New[kubeflow.FailureCause]()
+	for i, rule := range rules {
+		rulePath := path.Index(i)
+		if !validFailureCauses.Has(string(rule.Cause)) {
+			errs = append(errs, field.NotSupported(rulePath.Child("cause"), rule.Cause, validFailureCauses.List()))
+		} else if seen.Has(rule.Cause) {
+			errs = append(errs, field.Duplicate(rulePath.Child("cause"), rule.Cause))
+		}
+		seen.Insert(rule.Cause)
+		if !validFailureActions.Has(string(rule.Action)) {
+			errs = append(errs, field.NotSupported(rulePath.Child("action"), rule.Action, validFailureActions.List()))
+		}
+	}
+	return errs
+}
+
+func validateSchedulingPolicy(policy *kubeflow.SchedulingPolicy, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if policy.ScheduleTimeoutSeconds != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*policy.ScheduleTimeoutSeconds), path.Child("scheduleTimeoutSeconds"))...)
+	}
+	if policy.ScheduleTimeoutAction != "" && !validScheduleTimeoutActions.Has(string(policy.ScheduleTimeoutAction)) {
+		errs = append(errs, field.NotSupported(path.Child("scheduleTimeoutAction"), policy.ScheduleTimeoutAction, validScheduleTimeoutActions.List()))
+	}
+	if policy.TopologyKey != "" {
+		for _, msg := range apimachineryvalidation.IsQualifiedName(policy.TopologyKey) {
+			errs = append(errs, field.Invalid(path.Child("topologyKey"), policy.TopologyKey, msg))
+		}
+	}
 	return errs
 }
 
@@ -117,9 +357,55 @@ func validateMPIReplicaSpecs(replicaSpecs map[kubeflow.MPIReplicaType]*kubeflow.
 	}
 	errs = append(errs, validateLauncherReplicaSpec(replicaSpecs[kubeflow.MPIReplicaTypeLauncher], path.Key(string(kubeflow.MPIReplicaTypeLauncher)))...)
 	errs = append(errs, validateWorkerReplicaSpec(replicaSpecs[kubeflow.MPIReplicaTypeWorker], path.Key(string(kubeflow.MPIReplicaTypeWorker)))...)
+	// Any other replica type is a named worker group. Its lowercase name is
+	// used in the names of its Pods and must not collide with another group.
+	groupNames := map[string]kubeflow.MPIReplicaType{
+		strings.ToLower(string(kubeflow.MPIReplicaTypeLauncher)): kubeflow.MPIReplicaTypeLauncher,
+		strings.ToLower(string(kubeflow.MPIReplicaTypeWorker)):   kubeflow.MPIReplicaTypeWorker,
+	}
+	for _, rType := range sortedReplicaTypes(replicaSpecs) {
+		if rType == kubeflow.MPIReplicaTypeLauncher || rType == kubeflow.MPIReplicaTypeWorker {
+			continue
+		}
+		groupPath := path.Key(string(rType))
+		name := strings.ToLower(string(rType))
+		if other, ok := groupNames[name]; ok {
+			errs = append(errs, field.Invalid(groupPath, rType, fmt.Sprintf("conflicts with %s", other)))
+			continue
+		}
+		groupNames[name] = rType
+		if msgs := apimachineryvalidation.IsDNS1035Label(name); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(groupPath, rType, fmt.Sprintf("worker group name must be a valid DNS label once lowercased: %s", strings.Join(msgs, ", "))))
+			continue
+		}
+		if replicaSpecs[rType] == nil {
+			errs = append(errs, field.Required(groupPath, "must have worker group replica spec"))
+			continue
+		}
+		errs = append(errs, validateWorkerReplicaSpec(replicaSpecs[rType], groupPath)...)
+		if replicaSpecs[rType].MinReplicas != nil {
+			errs = append(errs, field.Forbidden(groupPath.Child("minReplicas"), fmt.Sprintf("only allowed for %s replica spec", kubeflow.MPIReplicaTypeWorker)))
+		}
+		if replicaSpecs[rType].MaxReplicas != nil {
+			errs = append(errs, field.Forbidden(groupPath.Child("maxReplicas"), fmt.Sprintf("only allowed for %s replica spec", kubeflow.MPIReplicaTypeWorker)))
+		}
+	}
 	return errs
 }
 
+// sortedReplicaTypes returns the replica types in the given specs, sorted to
+// report errors in a stable order.
+func sortedReplicaTypes(replicaSpecs map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec) []kubeflow.MPIReplicaType {
+	rTypes := make([]kubeflow.MPIReplicaType, 0, len(replicaSpecs))
+	for rType := range replicaSpecs {
+		rTypes = append(rTypes, rType)
+	}
+	sort.Slice(rTypes, func(i, j int) bool {
+		return rTypes[i] < rTypes[j]
+	})
+	return rTypes
+}
+
 func validateLauncherReplicaSpec(spec *kubeflow.ReplicaSpec, path *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	if spec == nil {
@@ -130,6 +416,15 @@ func validateLauncherReplicaSpec(spec *kubeflow.ReplicaSpec, path *field.Path) f
 	if spec.Replicas != nil && *spec.Replicas != 1 {
 		errs = append(errs, field.Invalid(path.Child("replicas"), *spec.Replicas, "must be 1"))
 	}
+	if spec.MinReplicas != nil {
+		errs = append(errs, field.Forbidden(path.Child("minReplicas"), fmt.Sprintf("only allowed for %s replica spec", kubeflow.MPIReplicaTypeWorker)))
+	}
+	if spec.MaxReplicas != nil {
+		errs = append(errs, field.Forbidden(path.Child("maxReplicas"), fmt.Sprintf("only allowed for %s replica spec", kubeflow.MPIReplicaTypeWorker)))
+	}
+	if spec.SlotsPerWorker != nil {
+		errs = append(errs, field.Forbidden(path.Child("slotsPerWorker"), "only allowed for worker replica specs"))
+	}
 	return errs
 }
 
@@ -142,6 +437,30 @@ func validateWorkerReplicaSpec(spec *kubeflow.ReplicaSpec, path *field.Path) fie
 	if spec.Replicas != nil && *spec.Replicas <= 0 {
 		errs = append(errs, field.Invalid(path.Child("replicas"), *spec.Replicas, "must be greater than or equal to 1"))
 	}
+	if spec.SlotsPerWorker != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*spec.SlotsPerWorker), path.Child("slotsPerWorker"))...)
+	}
+	errs = append(errs, validateElasticReplicas(spec, path)...)
+	return errs
+}
+
+func validateElasticReplicas(spec *kubeflow.ReplicaSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec.MinReplicas != nil && *spec.MinReplicas <= 0 {
+		errs = append(errs, field.Invalid(path.Child("minReplicas"), *spec.MinReplicas, "must be greater than or equal to 1"))
+	}
+	if spec.MaxReplicas != nil && spec.MinReplicas != nil && *spec.MaxReplicas < *spec.MinReplicas {
+		errs = append(errs, field.Invalid(path.Child("maxReplicas"), *spec.MaxReplicas, "must be greater than or equal to minReplicas"))
+	}
+	if spec.Replicas == nil {
+		return errs
+	}
+	if spec.MinReplicas != nil && *spec.Replicas < *spec.MinReplicas {
+		errs = append(errs, field.Invalid(path.Child("replicas"), *spec.Replicas, "must be greater than or equal to minReplicas"))
+	}
+	if spec.MaxReplicas != nil && *spec.Replicas > *spec.MaxReplicas {
+		errs = append(errs, field.Invalid(path.Child("replicas"), *spec.Replicas, "must be less than or equal to maxReplicas"))
+	}
 	return errs
 }
 
@@ -156,5 +475,30 @@ func validateReplicaSpec(spec *kubeflow.ReplicaSpec, path *field.Path) field.Err
 	if len(spec.Template.Spec.Containers) == 0 {
 		errs = append(errs, field.Required(path.Child("template", "spec", "containers"), "must define at least one container"))
 	}
+	errs = append(errs, validateRetryableExitCodes(spec, path.Child("retryableExitCodes"))...)
+	return errs
+}
+
+func validateRetryableExitCodes(spec *kubeflow.ReplicaSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(spec.RetryableExitCodes) == 0 {
+		return errs
+	}
+	if spec.RestartPolicy != kubeflow.RestartPolicyExitCode {
+		errs = append(errs, field.Forbidden(path, fmt.Sprintf("only allowed when restartPolicy is %s", kubeflow.RestartPolicyExitCode)))
+		return errs
+	}
+	if len(spec.RetryableExitCodes) > maxRetryableExitCodes {
+		errs = append(errs, field.TooMany(path, len(spec.RetryableExitCodes), maxRetryableExitCodes))
+	}
+	seen := sets.New[int32]()
+	for i, code := range spec.RetryableExitCodes {
+		if code < 1 || code > 255 {
+			errs = append(errs, field.Invalid(path.Index(i), code, "must be between 1 and 255, inclusive"))
+		} else if seen.Has(code) {
+			errs = append(errs, field.Duplicate(path.Index(i), code))
+		}
+		seen.Insert(code)
+	}
 	return errs
 }
@@ -185,18 +185,43 @@ func TestValidateGroupJob(t *testing.T) {
 			job: kubeflow.GroupJob{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "this-name-is-waaaaaaaay-too-long-for-a-worker-hostname",
+					Annotations: map[string]string{
+						kubeflow.DebugHoldAnnotation: "forever",
+					},
 				},
 				Spec: kubeflow.GroupJobSpec{
 					SlotsPerWorker: ptr.To[int32](2),
 					RunPolicy: kubeflow.RunPolicy{
-						CleanPodPolicy:          ptr.To[kubeflow.CleanPodPolicy]("unknown"),
-						TTLSecondsAfterFinished: ptr.To[int32](-1),
-						ActiveDeadlineSeconds:   ptr.To[int64](-1),
-						BackoffLimit:            ptr.To[int32](-1),
-						ManagedBy:               ptr.To("invalid.com/controller"),
+						CleanPodPolicy: ptr.To[kubeflow.CleanPodPolicy]("unknown"),
+						CleanupPolicy: &kubeflow.CleanupPolicy{
+							Workers:   ptr.To(kubeflow.CleanPodPolicyRunning),
+							Launcher:  ptr.To[kubeflow.CleanPodPolicy]("Finished"),
+							Resources: ptr.To(kubeflow.CleanPodPolicyRunning),
+						},
+						TTLSecondsAfterFinished:   ptr.To[int32](-1),
+						SuspendGracePeriodSeconds: ptr.To[int32](-1),
+						DebugHoldSeconds:          ptr.To[int32](-1),
+						ActiveDeadlineSeconds:     ptr.To[int64](-1),
+						BackoffLimit:              ptr.To[int32](-1),
+						RestartMode:               ptr.To[kubeflow.RestartMode]("Unknown"),
+						NodeFailureLimit:          ptr.To[int32](0),
+						FailurePolicy: []kubeflow.FailurePolicyRule{
+							{Cause: kubeflow.FailureCauseOOMKilled, Action: kubeflow.FailureActionFail},
+							{Cause: kubeflow.FailureCause("Crashed"), Action: kubeflow.FailureActionFail},
+							{Cause: kubeflow.FailureCauseOOMKilled, Action: kubeflow.FailureAction("Ignore")},
+						},
+						ManagedBy: ptr.To("invalid.com/controller"),
+						SchedulingPolicy: &kubeflow.SchedulingPolicy{
+							ScheduleTimeoutSeconds: ptr.To[int32](-1),
+							ScheduleTimeoutAction:  kubeflow.ScheduleTimeoutAction("Retry"),
+							TopologyKey:            "invalid key",
+						},
 					},
 					SSHAuthMountPath:  "/root/.ssh",
 					MPIImplementation: kubeflow.MPIImplementation("Unknown"),
+					BootstrapMode:     kubeflow.BootstrapMode("Unknown"),
+					SSHAuthSecretName: "Invalid_Name",
+					SSHKeyAlgorithm:   kubeflow.SSHKeyAlgorithm("DSA"),
 					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
 						kubeflow.MPIReplicaTypeLauncher: {
 							Replicas:      ptr.To[int32](1),
@@ -224,14 +249,34 @@ func TestValidateGroupJob(t *testing.T) {
 					Type:  field.ErrorTypeInvalid,
 					Field: "metadata.name",
 				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[training.coreweave.com/debug-hold]",
+				},
 				{
 					Type:  field.ErrorTypeNotSupported,
 					Field: "spec.runPolicy.cleanPodPolicy",
 				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.runPolicy.cleanupPolicy.launcher",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.runPolicy.cleanupPolicy.resources",
+				},
 				{
 					Type:  field.ErrorTypeInvalid,
 					Field: "spec.runPolicy.ttlSecondsAfterFinished",
 				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.runPolicy.suspendGracePeriodSeconds",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.runPolicy.debugHoldSeconds",
+				},
 				{
 					Type:  field.ErrorTypeInvalid,
 					Field: "spec.runPolicy.activeDeadlineSeconds",
@@ -240,15 +285,198 @@ func TestValidateGroupJob(t *testing.T) {
 					Type:  field.ErrorTypeInvalid,
 					Field: "spec.runPolicy.backoffLimit",
 				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.runPolicy.restartMode",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.runPolicy.nodeFailureLimit",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.runPolicy.failurePolicy[1].cause",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.runPolicy.failurePolicy[2].cause",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.runPolicy.failurePolicy[2].action",
+				},
 				{
 					Type:  field.ErrorTypeNotSupported,
 					Field: "spec.runPolicy.managedBy",
 				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.runPolicy.schedulingPolicy.scheduleTimeoutSeconds",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.runPolicy.schedulingPolicy.scheduleTimeoutAction",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.runPolicy.schedulingPolicy.topologyKey",
+				},
 				{
 					Type:  field.ErrorTypeNotSupported,
 					Field: "spec.mpiImplementation",
 				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.bootstrapMode",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshAuthSecretName",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.sshKeyAlgorithm",
+				},
+			},
+		},
+		"valid non-root SSH": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					SSHRunAsNonRoot:   ptr.To(true),
+					SSHPort:           ptr.To[int32](2222),
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid non-root SSH": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					BootstrapMode:     kubeflow.BootstrapModeExec,
+					SSHAuthSecretName: "team-ssh",
+					SSHRunAsNonRoot:   ptr.To(true),
+					SSHPort:           ptr.To[int32](22),
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.sshAuthSecretName",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.sshRunAsNonRoot",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.sshRunAsNonRoot",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshPort",
+				},
+			},
+		},
+		"SSH port without non-root SSH": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					SSHPort:           ptr.To[int32](2222),
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeForbidden,
+				Field: "spec.sshPort",
+			}},
+		},
+		"SSH Secret in the Exec bootstrap mode": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					BootstrapMode:     kubeflow.BootstrapModeExec,
+					SSHAuthSecretName: "team-ssh",
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
 			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeForbidden,
+				Field: "spec.sshAuthSecretName",
+			}},
 		},
 		"empty replica specs": {
 			job: kubeflow.GroupJob{
@@ -370,22 +598,32 @@ func TestValidateGroupJob(t *testing.T) {
 				},
 			},
 		},
-		"invalid mpiJob name": {
+		"valid with ExitCode restart policy": {
 			job: kubeflow.GroupJob{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "1-foo",
+					Name: "foo",
 				},
 				Spec: kubeflow.GroupJobSpec{
 					SlotsPerWorker: ptr.To[int32](2),
 					RunPolicy: kubeflow.RunPolicy{
 						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
 					},
-					SSHAuthMountPath:  "/home/mpiuser/.ssh",
-					MPIImplementation: kubeflow.MPIImplementationIntel,
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
 					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
 						kubeflow.MPIReplicaTypeLauncher: {
 							Replicas:      ptr.To[int32](1),
-							RestartPolicy: kubeflow.RestartPolicyNever,
+							RestartPolicy: kubeflow.RestartPolicyExitCode,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:           ptr.To[int32](2),
+							RestartPolicy:      kubeflow.RestartPolicyExitCode,
+							RetryableExitCodes: []int32{1, 137},
 							Template: corev1.PodTemplateSpec{
 								Spec: corev1.PodSpec{
 									Containers: []corev1.Container{{}},
@@ -395,15 +633,593 @@ func TestValidateGroupJob(t *testing.T) {
 					},
 				},
 			},
-			wantErrs: field.ErrorList{{
-				Type:  field.ErrorTypeInvalid,
-				Field: "metadata.name",
-			}},
 		},
-	}
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := ValidateGroupJob(&tc.job)
+		"invalid retryable exit codes": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:           ptr.To[int32](1),
+							RestartPolicy:      kubeflow.RestartPolicyNever,
+							RetryableExitCodes: []int32{137},
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:           ptr.To[int32](2),
+							RestartPolicy:      kubeflow.RestartPolicyExitCode,
+							RetryableExitCodes: []int32{0, 137, 137, 256},
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[Launcher].retryableExitCodes",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Worker].retryableExitCodes[0]",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.mpiReplicaSpecs[Worker].retryableExitCodes[2]",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Worker].retryableExitCodes[3]",
+				},
+			},
+		},
+		"valid elastic workers": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](3),
+							MinReplicas:   ptr.To[int32](2),
+							MaxReplicas:   ptr.To[int32](4),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid elastic workers": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							MinReplicas:   ptr.To[int32](1),
+							MaxReplicas:   ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](5),
+							MinReplicas:   ptr.To[int32](4),
+							MaxReplicas:   ptr.To[int32](2),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[Launcher].minReplicas",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[Launcher].maxReplicas",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Worker].maxReplicas",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Worker].replicas",
+				},
+			},
+		},
+		"valid worker groups": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:       ptr.To[int32](4),
+							SlotsPerWorker: ptr.To[int32](8),
+							RestartPolicy:  kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						"HighMem": {
+							Replicas:       ptr.To[int32](2),
+							SlotsPerWorker: ptr.To[int32](1),
+							RestartPolicy:  kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid worker groups": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:       ptr.To[int32](1),
+							SlotsPerWorker: ptr.To[int32](1),
+							RestartPolicy:  kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						"GPU": {
+							Replicas:       ptr.To[int32](2),
+							MinReplicas:    ptr.To[int32](1),
+							SlotsPerWorker: ptr.To[int32](-1),
+							RestartPolicy:  kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						"gpu": {
+							Replicas:      ptr.To[int32](2),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						"launcher": {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						"high_mem": {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[Launcher].slotsPerWorker",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[GPU].slotsPerWorker",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[GPU].minReplicas",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[gpu]",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[high_mem]",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[launcher]",
+				},
+			},
+		},
+		"invalid mpiJob name with worker groups": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo-bar-baz",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/root/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						"VeryLongWorkerGroupNameThatDoesNotFitInAHostnameLabel": {
+							Replicas:      ptr.To[int32](10),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeInvalid,
+				Field: "metadata.name",
+			}},
+		},
+		"invalid mpiJob name": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "1-foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationIntel,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeInvalid,
+				Field: "metadata.name",
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateGroupJob(&tc.job)
+			if diff := cmp.Diff(tc.wantErrs, got, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateGroupJobUpdate(t *testing.T) {
+	startTime := metav1.Now()
+	oldJob := kubeflow.GroupJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+		Spec: kubeflow.GroupJobSpec{
+			SlotsPerWorker: ptr.To[int32](2),
+			RunPolicy: kubeflow.RunPolicy{
+				CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+			},
+			SSHAuthMountPath:  "/root/.ssh",
+			MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Replicas:      ptr.To[int32](1),
+					RestartPolicy: kubeflow.RestartPolicyNever,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Image: "foo"}},
+						},
+					},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas:      ptr.To[int32](2),
+					RestartPolicy: kubeflow.RestartPolicyNever,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Image: "foo"}},
+						},
+					},
+				},
+			},
+		},
+		Status: kubeflow.JobStatus{
+			StartTime: &startTime,
+		},
+	}
+	cases := map[string]struct {
+		oldJob   func(*kubeflow.GroupJob)
+		update   func(*kubeflow.GroupJob)
+		wantErrs field.ErrorList
+	}{
+		"scale workers while running": {
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](4)
+			},
+		},
+		"change templates, implementation and bootstrap mode while running": {
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIImplementation = kubeflow.MPIImplementationIntel
+				job.Spec.BootstrapMode = kubeflow.BootstrapModeExec
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Image = "bar"
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "bar"
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiImplementation",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.bootstrapMode",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Launcher].template",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Worker].template",
+				},
+			},
+		},
+		"change SSH keys while running": {
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.SSHAuthSecretName = "team-ssh"
+				job.Spec.SSHKeyAlgorithm = kubeflow.SSHKeyAlgorithmEd25519
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshAuthSecretName",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshKeyAlgorithm",
+				},
+			},
+		},
+		"run sshd as non-root while running": {
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.SSHRunAsNonRoot = ptr.To(true)
+				job.Spec.SSHPort = ptr.To[int32](2222)
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshRunAsNonRoot",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshPort",
+				},
+			},
+		},
+		"change SSH keys while suspended": {
+			oldJob: func(job *kubeflow.GroupJob) {
+				job.Spec.RunPolicy.Suspend = ptr.To(true)
+			},
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.SSHAuthSecretName = "team-ssh"
+				job.Spec.SSHKeyAlgorithm = kubeflow.SSHKeyAlgorithmRSA
+			},
+		},
+		"add and remove worker groups while running": {
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIReplicaSpecs["GPU"] = job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+				delete(job.Spec.MPIReplicaSpecs, kubeflow.MPIReplicaTypeWorker)
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[GPU]",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[Worker]",
+				},
+			},
+		},
+		"change templates while suspended": {
+			oldJob: func(job *kubeflow.GroupJob) {
+				job.Spec.RunPolicy.Suspend = ptr.To(true)
+			},
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIImplementation = kubeflow.MPIImplementationIntel
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "bar"
+			},
+		},
+		"change templates before the job started": {
+			oldJob: func(job *kubeflow.GroupJob) {
+				job.Status.StartTime = nil
+			},
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "bar"
+			},
+		},
+		"change templates after the job finished": {
+			oldJob: func(job *kubeflow.GroupJob) {
+				job.Status.Conditions = []kubeflow.JobCondition{{
+					Type:   kubeflow.JobSucceeded,
+					Status: corev1.ConditionTrue,
+				}}
+			},
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "bar"
+			},
+		},
+		"invalid update": {
+			update: func(job *kubeflow.GroupJob) {
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](0)
+			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeInvalid,
+				Field: "spec.mpiReplicaSpecs[Worker].replicas",
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			old := oldJob.DeepCopy()
+			if tc.oldJob != nil {
+				tc.oldJob(old)
+			}
+			job := old.DeepCopy()
+			tc.update(job)
+			got := ValidateGroupJobUpdate(old, job)
+			if diff := cmp.Diff(tc.wantErrs, got, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateSSHAuthSecret(t *testing.T) {
+	job := &kubeflow.GroupJob{
+		Spec: kubeflow.GroupJobSpec{
+			SSHAuthSecretName: "team-ssh",
+		},
+	}
+	cases := map[string]struct {
+		data     map[string][]byte
+		wantErrs field.ErrorList
+	}{
+		"valid": {
+			data: map[string][]byte{
+				corev1.SSHAuthPrivateKey: []byte("private"),
+				"ssh-publickey":          []byte("public"),
+			},
+		},
+		"missing and empty keys": {
+			data: map[string][]byte{
+				"ssh-publickey": {},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshAuthSecretName",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.sshAuthSecretName",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateSSHAuthSecret(job, &corev1.Secret{Data: tc.data})
 			if diff := cmp.Diff(tc.wantErrs, got, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
 				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
 			}
@@ -0,0 +1,59 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+)
+
+// CleanupPolicyApplyConfiguration represents a declarative configuration of the CleanupPolicy type for use
+// with apply.
+type CleanupPolicyApplyConfiguration struct {
+	Workers   *v1.CleanPodPolicy `json:"workers,omitempty"`
+	Launcher  *v1.CleanPodPolicy `json:"launcher,omitempty"`
+	Resources *v1.CleanPodPolicy `json:"resources,omitempty"`
+}
+
+// CleanupPolicyApplyConfiguration constructs a declarative configuration of the CleanupPolicy type for use with
+// apply.
+func CleanupPolicy() *CleanupPolicyApplyConfiguration {
+	return &CleanupPolicyApplyConfiguration{}
+}
+
+// WithWorkers sets the Workers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Workers field is set to the value of the last call.
+func (b *CleanupPolicyApplyConfiguration) WithWorkers(value v1.CleanPodPolicy) *CleanupPolicyApplyConfiguration {
+	b.Workers = &value
+	return b
+}
+
+// WithLauncher sets the Launcher field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Launcher field is set to the value of the last call.
+func (b *CleanupPolicyApplyConfiguration) WithLauncher(value v1.CleanPodPolicy) *CleanupPolicyApplyConfiguration {
+	b.Launcher = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *CleanupPolicyApplyConfiguration) WithResources(value v1.CleanPodPolicy) *CleanupPolicyApplyConfiguration {
+	b.Resources = &value
+	return b
+}
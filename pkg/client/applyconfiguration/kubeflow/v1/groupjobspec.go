@@ -0,0 +1,163 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+)
+
+// GroupJobSpecApplyConfiguration represents a declarative configuration of the GroupJobSpec type for use
+// with apply.
+type GroupJobSpecApplyConfiguration struct {
+	SlotsPerWorker         *int32                              `json:"slotsPerWorker,omitempty"`
+	RunLauncherAsWorker    *bool                               `json:"runLauncherAsWorker,omitempty"`
+	RunPolicy              *RunPolicyApplyConfiguration        `json:"runPolicy,omitempty"`
+	Launcher               *ReplicaSpecApplyConfiguration      `json:"launcher,omitempty"`
+	Worker                 *ReplicaSpecApplyConfiguration      `json:"worker,omitempty"`
+	WorkerGroups           []WorkerGroupSpecApplyConfiguration `json:"workerGroups,omitempty"`
+	SSHAuthMountPath       *string                             `json:"sshAuthMountPath,omitempty"`
+	BootstrapMode          *kubeflowv1.BootstrapMode           `json:"bootstrapMode,omitempty"`
+	SSHAuthSecretName      *string                             `json:"sshAuthSecretName,omitempty"`
+	SSHKeyAlgorithm        *kubeflowv1.SSHKeyAlgorithm         `json:"sshKeyAlgorithm,omitempty"`
+	SSHRunAsNonRoot        *bool                               `json:"sshRunAsNonRoot,omitempty"`
+	SSHPort                *int32                              `json:"sshPort,omitempty"`
+	LauncherCreationPolicy *kubeflowv1.LauncherCreationPolicy  `json:"launcherCreationPolicy,omitempty"`
+	MPIImplementation      *kubeflowv1.MPIImplementation       `json:"mpiImplementation,omitempty"`
+}
+
+// GroupJobSpecApplyConfiguration constructs a declarative configuration of the GroupJobSpec type for use with
+// apply.
+func GroupJobSpec() *GroupJobSpecApplyConfiguration {
+	return &GroupJobSpecApplyConfiguration{}
+}
+
+// WithSlotsPerWorker sets the SlotsPerWorker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SlotsPerWorker field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSlotsPerWorker(value int32) *GroupJobSpecApplyConfiguration {
+	b.SlotsPerWorker = &value
+	return b
+}
+
+// WithRunLauncherAsWorker sets the RunLauncherAsWorker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunLauncherAsWorker field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithRunLauncherAsWorker(value bool) *GroupJobSpecApplyConfiguration {
+	b.RunLauncherAsWorker = &value
+	return b
+}
+
+// WithRunPolicy sets the RunPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunPolicy field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithRunPolicy(value *RunPolicyApplyConfiguration) *GroupJobSpecApplyConfiguration {
+	b.RunPolicy = value
+	return b
+}
+
+// WithLauncher sets the Launcher field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Launcher field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithLauncher(value *ReplicaSpecApplyConfiguration) *GroupJobSpecApplyConfiguration {
+	b.Launcher = value
+	return b
+}
+
+// WithWorker sets the Worker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Worker field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithWorker(value *ReplicaSpecApplyConfiguration) *GroupJobSpecApplyConfiguration {
+	b.Worker = value
+	return b
+}
+
+// WithWorkerGroups adds the given value to the WorkerGroups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerGroups field.
+func (b *GroupJobSpecApplyConfiguration) WithWorkerGroups(values ...*WorkerGroupSpecApplyConfiguration) *GroupJobSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerGroups")
+		}
+		b.WorkerGroups = append(b.WorkerGroups, *values[i])
+	}
+	return b
+}
+
+// WithSSHAuthMountPath sets the SSHAuthMountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHAuthMountPath field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHAuthMountPath(value string) *GroupJobSpecApplyConfiguration {
+	b.SSHAuthMountPath = &value
+	return b
+}
+
+// WithBootstrapMode sets the BootstrapMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapMode field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithBootstrapMode(value kubeflowv1.BootstrapMode) *GroupJobSpecApplyConfiguration {
+	b.BootstrapMode = &value
+	return b
+}
+
+// WithSSHAuthSecretName sets the SSHAuthSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHAuthSecretName field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHAuthSecretName(value string) *GroupJobSpecApplyConfiguration {
+	b.SSHAuthSecretName = &value
+	return b
+}
+
+// WithSSHKeyAlgorithm sets the SSHKeyAlgorithm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHKeyAlgorithm field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHKeyAlgorithm(value kubeflowv1.SSHKeyAlgorithm) *GroupJobSpecApplyConfiguration {
+	b.SSHKeyAlgorithm = &value
+	return b
+}
+
+// WithSSHRunAsNonRoot sets the SSHRunAsNonRoot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHRunAsNonRoot field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHRunAsNonRoot(value bool) *GroupJobSpecApplyConfiguration {
+	b.SSHRunAsNonRoot = &value
+	return b
+}
+
+// WithSSHPort sets the SSHPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHPort field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHPort(value int32) *GroupJobSpecApplyConfiguration {
+	b.SSHPort = &value
+	return b
+}
+
+// WithLauncherCreationPolicy sets the LauncherCreationPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LauncherCreationPolicy field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithLauncherCreationPolicy(value kubeflowv1.LauncherCreationPolicy) *GroupJobSpecApplyConfiguration {
+	b.LauncherCreationPolicy = &value
+	return b
+}
+
+// WithMPIImplementation sets the MPIImplementation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MPIImplementation field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithMPIImplementation(value kubeflowv1.MPIImplementation) *GroupJobSpecApplyConfiguration {
+	b.MPIImplementation = &value
+	return b
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobStatusApplyConfiguration represents a declarative configuration of the JobStatus type for use
+// with apply.
+type JobStatusApplyConfiguration struct {
+	ObservedGeneration *int64                                `json:"observedGeneration,omitempty"`
+	Conditions         []JobConditionApplyConfiguration      `json:"conditions,omitempty"`
+	Launcher           *ReplicaStatusApplyConfiguration      `json:"launcher,omitempty"`
+	Worker             *ReplicaStatusApplyConfiguration      `json:"worker,omitempty"`
+	WorkerGroups       []WorkerGroupStatusApplyConfiguration `json:"workerGroups,omitempty"`
+	StartTime          *metav1.Time                          `json:"startTime,omitempty"`
+	CompletionTime     *metav1.Time                          `json:"completionTime,omitempty"`
+	LastReconcileTime  *metav1.Time                          `json:"lastReconcileTime,omitempty"`
+	RestartCount       *int32                                `json:"restartCount,omitempty"`
+	LastRestartTime    *metav1.Time                          `json:"lastRestartTime,omitempty"`
+	ElasticReplicas    *int32                                `json:"elasticReplicas,omitempty"`
+	PodGroupPhase      *kubeflowv1.PodGroupPhase             `json:"podGroupPhase,omitempty"`
+	NodeFailures       []NodeFailureApplyConfiguration       `json:"nodeFailures,omitempty"`
+}
+
+// JobStatusApplyConfiguration constructs a declarative configuration of the JobStatus type for use with
+// apply.
+func JobStatus() *JobStatusApplyConfiguration {
+	return &JobStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithObservedGeneration(value int64) *JobStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *JobStatusApplyConfiguration) WithConditions(values ...*JobConditionApplyConfiguration) *JobStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithLauncher sets the Launcher field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Launcher field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLauncher(value *ReplicaStatusApplyConfiguration) *JobStatusApplyConfiguration {
+	b.Launcher = value
+	return b
+}
+
+// WithWorker sets the Worker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Worker field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithWorker(value *ReplicaStatusApplyConfiguration) *JobStatusApplyConfiguration {
+	b.Worker = value
+	return b
+}
+
+// WithWorkerGroups adds the given value to the WorkerGroups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerGroups field.
+func (b *JobStatusApplyConfiguration) WithWorkerGroups(values ...*WorkerGroupStatusApplyConfiguration) *JobStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerGroups")
+		}
+		b.WorkerGroups = append(b.WorkerGroups, *values[i])
+	}
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithStartTime(value metav1.Time) *JobStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *JobStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithLastReconcileTime sets the LastReconcileTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastReconcileTime field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLastReconcileTime(value metav1.Time) *JobStatusApplyConfiguration {
+	b.LastReconcileTime = &value
+	return b
+}
+
+// WithRestartCount sets the RestartCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartCount field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithRestartCount(value int32) *JobStatusApplyConfiguration {
+	b.RestartCount = &value
+	return b
+}
+
+// WithLastRestartTime sets the LastRestartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestartTime field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLastRestartTime(value metav1.Time) *JobStatusApplyConfiguration {
+	b.LastRestartTime = &value
+	return b
+}
+
+// WithElasticReplicas sets the ElasticReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ElasticReplicas field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithElasticReplicas(value int32) *JobStatusApplyConfiguration {
+	b.ElasticReplicas = &value
+	return b
+}
+
+// WithPodGroupPhase sets the PodGroupPhase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodGroupPhase field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithPodGroupPhase(value kubeflowv1.PodGroupPhase) *JobStatusApplyConfiguration {
+	b.PodGroupPhase = &value
+	return b
+}
+
+// WithNodeFailures adds the given value to the NodeFailures field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeFailures field.
+func (b *JobStatusApplyConfiguration) WithNodeFailures(values ...*NodeFailureApplyConfiguration) *JobStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodeFailures")
+		}
+		b.NodeFailures = append(b.NodeFailures, *values[i])
+	}
+	return b
+}
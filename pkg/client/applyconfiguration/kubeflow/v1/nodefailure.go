@@ -0,0 +1,59 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeFailureApplyConfiguration represents a declarative configuration of the NodeFailure type for use
+// with apply.
+type NodeFailureApplyConfiguration struct {
+	NodeName        *string  `json:"nodeName,omitempty"`
+	Failures        *int32   `json:"failures,omitempty"`
+	LastFailureTime *v1.Time `json:"lastFailureTime,omitempty"`
+}
+
+// NodeFailureApplyConfiguration constructs a declarative configuration of the NodeFailure type for use with
+// apply.
+func NodeFailure() *NodeFailureApplyConfiguration {
+	return &NodeFailureApplyConfiguration{}
+}
+
+// WithNodeName sets the NodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeName field is set to the value of the last call.
+func (b *NodeFailureApplyConfiguration) WithNodeName(value string) *NodeFailureApplyConfiguration {
+	b.NodeName = &value
+	return b
+}
+
+// WithFailures sets the Failures field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failures field is set to the value of the last call.
+func (b *NodeFailureApplyConfiguration) WithFailures(value int32) *NodeFailureApplyConfiguration {
+	b.Failures = &value
+	return b
+}
+
+// WithLastFailureTime sets the LastFailureTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailureTime field is set to the value of the last call.
+func (b *NodeFailureApplyConfiguration) WithLastFailureTime(value v1.Time) *NodeFailureApplyConfiguration {
+	b.LastFailureTime = &value
+	return b
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ReplicaSpecApplyConfiguration represents a declarative configuration of the ReplicaSpec type for use
+// with apply.
+type ReplicaSpecApplyConfiguration struct {
+	Replicas           *int32                    `json:"replicas,omitempty"`
+	MinReplicas        *int32                    `json:"minReplicas,omitempty"`
+	MaxReplicas        *int32                    `json:"maxReplicas,omitempty"`
+	SlotsPerWorker     *int32                    `json:"slotsPerWorker,omitempty"`
+	Template           *v1.PodTemplateSpec       `json:"template,omitempty"`
+	RestartPolicy      *kubeflowv1.RestartPolicy `json:"restartPolicy,omitempty"`
+	RetryableExitCodes []int32                   `json:"retryableExitCodes,omitempty"`
+}
+
+// ReplicaSpecApplyConfiguration constructs a declarative configuration of the ReplicaSpec type for use with
+// apply.
+func ReplicaSpec() *ReplicaSpecApplyConfiguration {
+	return &ReplicaSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithReplicas(value int32) *ReplicaSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithMinReplicas(value int32) *ReplicaSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithMaxReplicas(value int32) *ReplicaSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithSlotsPerWorker sets the SlotsPerWorker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SlotsPerWorker field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithSlotsPerWorker(value int32) *ReplicaSpecApplyConfiguration {
+	b.SlotsPerWorker = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithTemplate(value v1.PodTemplateSpec) *ReplicaSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
+
+// WithRestartPolicy sets the RestartPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartPolicy field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithRestartPolicy(value kubeflowv1.RestartPolicy) *ReplicaSpecApplyConfiguration {
+	b.RestartPolicy = &value
+	return b
+}
+
+// WithRetryableExitCodes adds the given value to the RetryableExitCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RetryableExitCodes field.
+func (b *ReplicaSpecApplyConfiguration) WithRetryableExitCodes(values ...int32) *ReplicaSpecApplyConfiguration {
+	for i := range values {
+		b.RetryableExitCodes = append(b.RetryableExitCodes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// WorkerGroupStatusApplyConfiguration represents a declarative configuration of the WorkerGroupStatus type for use
+// with apply.
+type WorkerGroupStatusApplyConfiguration struct {
+	Name                            *string `json:"name,omitempty"`
+	ReplicaStatusApplyConfiguration `json:",inline"`
+}
+
+// WorkerGroupStatusApplyConfiguration constructs a declarative configuration of the WorkerGroupStatus type for use with
+// apply.
+func WorkerGroupStatus() *WorkerGroupStatusApplyConfiguration {
+	return &WorkerGroupStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithName(value string) *WorkerGroupStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithActive sets the Active field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Active field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithActive(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.Active = &value
+	return b
+}
+
+// WithSucceeded sets the Succeeded field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Succeeded field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithSucceeded(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.Succeeded = &value
+	return b
+}
+
+// WithFailed sets the Failed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failed field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithFailed(value int32) *WorkerGroupStatusApplyConfiguration {
+	b.Failed = &value
+	return b
+}
+
+// WithFailedByCause puts the entries into the FailedByCause field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the FailedByCause field,
+// overwriting an existing map entries in FailedByCause field with the same key.
+func (b *WorkerGroupStatusApplyConfiguration) WithFailedByCause(entries map[kubeflowv1.FailureCause]int32) *WorkerGroupStatusApplyConfiguration {
+	if b.FailedByCause == nil && len(entries) > 0 {
+		b.FailedByCause = make(map[kubeflowv1.FailureCause]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.FailedByCause[k] = v
+	}
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithLabelSelector(value *metav1.LabelSelectorApplyConfiguration) *WorkerGroupStatusApplyConfiguration {
+	b.LabelSelector = value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *WorkerGroupStatusApplyConfiguration) WithSelector(value string) *WorkerGroupStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v2beta1
+
+import (
+	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// FailurePolicyRuleApplyConfiguration represents a declarative configuration of the FailurePolicyRule type for use
+// with apply.
+type FailurePolicyRuleApplyConfiguration struct {
+	Cause  *v2beta1.FailureCause  `json:"cause,omitempty"`
+	Action *v2beta1.FailureAction `json:"action,omitempty"`
+}
+
+// FailurePolicyRuleApplyConfiguration constructs a declarative configuration of the FailurePolicyRule type for use with
+// apply.
+func FailurePolicyRule() *FailurePolicyRuleApplyConfiguration {
+	return &FailurePolicyRuleApplyConfiguration{}
+}
+
+// WithCause sets the Cause field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cause field is set to the value of the last call.
+func (b *FailurePolicyRuleApplyConfiguration) WithCause(value v2beta1.FailureCause) *FailurePolicyRuleApplyConfiguration {
+	b.Cause = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *FailurePolicyRuleApplyConfiguration) WithAction(value v2beta1.FailureAction) *FailurePolicyRuleApplyConfiguration {
+	b.Action = &value
+	return b
+}
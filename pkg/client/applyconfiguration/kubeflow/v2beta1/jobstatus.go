@@ -24,11 +24,17 @@ import (
 // JobStatusApplyConfiguration represents a declarative configuration of the JobStatus type for use
 // with apply.
 type JobStatusApplyConfiguration struct {
-	Conditions        []JobConditionApplyConfiguration                                  `json:"conditions,omitempty"`
-	ReplicaStatuses   map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaStatus `json:"replicaStatuses,omitempty"`
-	StartTime         *v1.Time                                                          `json:"startTime,omitempty"`
-	CompletionTime    *v1.Time                                                          `json:"completionTime,omitempty"`
-	LastReconcileTime *v1.Time                                                          `json:"lastReconcileTime,omitempty"`
+	ObservedGeneration *int64                                                            `json:"observedGeneration,omitempty"`
+	Conditions         []JobConditionApplyConfiguration                                  `json:"conditions,omitempty"`
+	ReplicaStatuses    map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaStatus `json:"replicaStatuses,omitempty"`
+	StartTime          *v1.Time                                                          `json:"startTime,omitempty"`
+	CompletionTime     *v1.Time                                                          `json:"completionTime,omitempty"`
+	LastReconcileTime  *v1.Time                                                          `json:"lastReconcileTime,omitempty"`
+	RestartCount       *int32                                                            `json:"restartCount,omitempty"`
+	LastRestartTime    *v1.Time                                                          `json:"lastRestartTime,omitempty"`
+	ElasticReplicas    *int32                                                            `json:"elasticReplicas,omitempty"`
+	PodGroupPhase      *kubeflowv2beta1.PodGroupPhase                                    `json:"podGroupPhase,omitempty"`
+	NodeFailures       []NodeFailureApplyConfiguration                                   `json:"nodeFailures,omitempty"`
 }
 
 // JobStatusApplyConfiguration constructs a declarative configuration of the JobStatus type for use with
@@ -37,6 +43,14 @@ func JobStatus() *JobStatusApplyConfiguration {
 	return &JobStatusApplyConfiguration{}
 }
 
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithObservedGeneration(value int64) *JobStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -87,3 +101,48 @@ func (b *JobStatusApplyConfiguration) WithLastReconcileTime(value v1.Time) *JobS
 	b.LastReconcileTime = &value
 	return b
 }
+
+// WithRestartCount sets the RestartCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartCount field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithRestartCount(value int32) *JobStatusApplyConfiguration {
+	b.RestartCount = &value
+	return b
+}
+
+// WithLastRestartTime sets the LastRestartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestartTime field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLastRestartTime(value v1.Time) *JobStatusApplyConfiguration {
+	b.LastRestartTime = &value
+	return b
+}
+
+// WithElasticReplicas sets the ElasticReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ElasticReplicas field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithElasticReplicas(value int32) *JobStatusApplyConfiguration {
+	b.ElasticReplicas = &value
+	return b
+}
+
+// WithPodGroupPhase sets the PodGroupPhase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodGroupPhase field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithPodGroupPhase(value kubeflowv2beta1.PodGroupPhase) *JobStatusApplyConfiguration {
+	b.PodGroupPhase = &value
+	return b
+}
+
+// WithNodeFailures adds the given value to the NodeFailures field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeFailures field.
+func (b *JobStatusApplyConfiguration) WithNodeFailures(values ...*NodeFailureApplyConfiguration) *JobStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodeFailures")
+		}
+		b.NodeFailures = append(b.NodeFailures, *values[i])
+	}
+	return b
+}
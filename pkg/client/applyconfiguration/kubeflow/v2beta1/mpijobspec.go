@@ -28,6 +28,11 @@ type GroupJobSpecApplyConfiguration struct {
 	RunPolicy              *RunPolicyApplyConfiguration                                    `json:"runPolicy,omitempty"`
 	MPIReplicaSpecs        map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaSpec `json:"mpiReplicaSpecs,omitempty"`
 	SSHAuthMountPath       *string                                                         `json:"sshAuthMountPath,omitempty"`
+	BootstrapMode          *kubeflowv2beta1.BootstrapMode                                  `json:"bootstrapMode,omitempty"`
+	SSHAuthSecretName      *string                                                         `json:"sshAuthSecretName,omitempty"`
+	SSHKeyAlgorithm        *kubeflowv2beta1.SSHKeyAlgorithm                                `json:"sshKeyAlgorithm,omitempty"`
+	SSHRunAsNonRoot        *bool                                                           `json:"sshRunAsNonRoot,omitempty"`
+	SSHPort                *int32                                                          `json:"sshPort,omitempty"`
 	LauncherCreationPolicy *kubeflowv2beta1.LauncherCreationPolicy                         `json:"launcherCreationPolicy,omitempty"`
 	MPIImplementation      *kubeflowv2beta1.MPIImplementation                              `json:"mpiImplementation,omitempty"`
 }
@@ -84,6 +89,46 @@ func (b *GroupJobSpecApplyConfiguration) WithSSHAuthMountPath(value string) *Gro
 	return b
 }
 
+// WithBootstrapMode sets the BootstrapMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapMode field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithBootstrapMode(value kubeflowv2beta1.BootstrapMode) *GroupJobSpecApplyConfiguration {
+	b.BootstrapMode = &value
+	return b
+}
+
+// WithSSHAuthSecretName sets the SSHAuthSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHAuthSecretName field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHAuthSecretName(value string) *GroupJobSpecApplyConfiguration {
+	b.SSHAuthSecretName = &value
+	return b
+}
+
+// WithSSHKeyAlgorithm sets the SSHKeyAlgorithm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHKeyAlgorithm field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHKeyAlgorithm(value kubeflowv2beta1.SSHKeyAlgorithm) *GroupJobSpecApplyConfiguration {
+	b.SSHKeyAlgorithm = &value
+	return b
+}
+
+// WithSSHRunAsNonRoot sets the SSHRunAsNonRoot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHRunAsNonRoot field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHRunAsNonRoot(value bool) *GroupJobSpecApplyConfiguration {
+	b.SSHRunAsNonRoot = &value
+	return b
+}
+
+// WithSSHPort sets the SSHPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHPort field is set to the value of the last call.
+func (b *GroupJobSpecApplyConfiguration) WithSSHPort(value int32) *GroupJobSpecApplyConfiguration {
+	b.SSHPort = &value
+	return b
+}
+
 // WithLauncherCreationPolicy sets the LauncherCreationPolicy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LauncherCreationPolicy field is set to the value of the last call.
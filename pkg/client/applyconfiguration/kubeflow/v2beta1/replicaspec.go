@@ -24,9 +24,13 @@ import (
 // ReplicaSpecApplyConfiguration represents a declarative configuration of the ReplicaSpec type for use
 // with apply.
 type ReplicaSpecApplyConfiguration struct {
-	Replicas      *int32                 `json:"replicas,omitempty"`
-	Template      *v1.PodTemplateSpec    `json:"template,omitempty"`
-	RestartPolicy *v2beta1.RestartPolicy `json:"restartPolicy,omitempty"`
+	Replicas           *int32                 `json:"replicas,omitempty"`
+	MinReplicas        *int32                 `json:"minReplicas,omitempty"`
+	MaxReplicas        *int32                 `json:"maxReplicas,omitempty"`
+	SlotsPerWorker     *int32                 `json:"slotsPerWorker,omitempty"`
+	Template           *v1.PodTemplateSpec    `json:"template,omitempty"`
+	RestartPolicy      *v2beta1.RestartPolicy `json:"restartPolicy,omitempty"`
+	RetryableExitCodes []int32                `json:"retryableExitCodes,omitempty"`
 }
 
 // ReplicaSpecApplyConfiguration constructs a declarative configuration of the ReplicaSpec type for use with
@@ -43,6 +47,30 @@ func (b *ReplicaSpecApplyConfiguration) WithReplicas(value int32) *ReplicaSpecAp
 	return b
 }
 
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithMinReplicas(value int32) *ReplicaSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithMaxReplicas(value int32) *ReplicaSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithSlotsPerWorker sets the SlotsPerWorker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SlotsPerWorker field is set to the value of the last call.
+func (b *ReplicaSpecApplyConfiguration) WithSlotsPerWorker(value int32) *ReplicaSpecApplyConfiguration {
+	b.SlotsPerWorker = &value
+	return b
+}
+
 // WithTemplate sets the Template field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Template field is set to the value of the last call.
@@ -58,3 +86,13 @@ func (b *ReplicaSpecApplyConfiguration) WithRestartPolicy(value v2beta1.RestartP
 	b.RestartPolicy = &value
 	return b
 }
+
+// WithRetryableExitCodes adds the given value to the RetryableExitCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RetryableExitCodes field.
+func (b *ReplicaSpecApplyConfiguration) WithRetryableExitCodes(values ...int32) *ReplicaSpecApplyConfiguration {
+	for i := range values {
+		b.RetryableExitCodes = append(b.RetryableExitCodes, values[i])
+	}
+	return b
+}
@@ -17,6 +17,7 @@
 package v2beta1
 
 import (
+	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -26,6 +27,7 @@ type ReplicaStatusApplyConfiguration struct {
 	Active        *int32                              `json:"active,omitempty"`
 	Succeeded     *int32                              `json:"succeeded,omitempty"`
 	Failed        *int32                              `json:"failed,omitempty"`
+	FailedByCause map[v2beta1.FailureCause]int32      `json:"failedByCause,omitempty"`
 	LabelSelector *v1.LabelSelectorApplyConfiguration `json:"labelSelector,omitempty"`
 	Selector      *string                             `json:"selector,omitempty"`
 }
@@ -60,6 +62,20 @@ func (b *ReplicaStatusApplyConfiguration) WithFailed(value int32) *ReplicaStatus
 	return b
 }
 
+// WithFailedByCause puts the entries into the FailedByCause field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the FailedByCause field,
+// overwriting an existing map entries in FailedByCause field with the same key.
+func (b *ReplicaStatusApplyConfiguration) WithFailedByCause(entries map[v2beta1.FailureCause]int32) *ReplicaStatusApplyConfiguration {
+	if b.FailedByCause == nil && len(entries) > 0 {
+		b.FailedByCause = make(map[v2beta1.FailureCause]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.FailedByCause[k] = v
+	}
+	return b
+}
+
 // WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LabelSelector field is set to the value of the last call.
@@ -23,13 +23,19 @@ import (
 // RunPolicyApplyConfiguration represents a declarative configuration of the RunPolicy type for use
 // with apply.
 type RunPolicyApplyConfiguration struct {
-	CleanPodPolicy          *v2beta1.CleanPodPolicy             `json:"cleanPodPolicy,omitempty"`
-	TTLSecondsAfterFinished *int32                              `json:"ttlSecondsAfterFinished,omitempty"`
-	ActiveDeadlineSeconds   *int64                              `json:"activeDeadlineSeconds,omitempty"`
-	BackoffLimit            *int32                              `json:"backoffLimit,omitempty"`
-	SchedulingPolicy        *SchedulingPolicyApplyConfiguration `json:"schedulingPolicy,omitempty"`
-	Suspend                 *bool                               `json:"suspend,omitempty"`
-	ManagedBy               *string                             `json:"managedBy,omitempty"`
+	CleanPodPolicy            *v2beta1.CleanPodPolicy               `json:"cleanPodPolicy,omitempty"`
+	CleanupPolicy             *CleanupPolicyApplyConfiguration      `json:"cleanupPolicy,omitempty"`
+	TTLSecondsAfterFinished   *int32                                `json:"ttlSecondsAfterFinished,omitempty"`
+	ActiveDeadlineSeconds     *int64                                `json:"activeDeadlineSeconds,omitempty"`
+	BackoffLimit              *int32                                `json:"backoffLimit,omitempty"`
+	RestartMode               *v2beta1.RestartMode                  `json:"restartMode,omitempty"`
+	NodeFailureLimit          *int32                                `json:"nodeFailureLimit,omitempty"`
+	FailurePolicy             []FailurePolicyRuleApplyConfiguration `json:"failurePolicy,omitempty"`
+	SchedulingPolicy          *SchedulingPolicyApplyConfiguration   `json:"schedulingPolicy,omitempty"`
+	Suspend                   *bool                                 `json:"suspend,omitempty"`
+	SuspendGracePeriodSeconds *int32                                `json:"suspendGracePeriodSeconds,omitempty"`
+	DebugHoldSeconds          *int32                                `json:"debugHoldSeconds,omitempty"`
+	ManagedBy                 *string                               `json:"managedBy,omitempty"`
 }
 
 // RunPolicyApplyConfiguration constructs a declarative configuration of the RunPolicy type for use with
@@ -46,6 +52,14 @@ func (b *RunPolicyApplyConfiguration) WithCleanPodPolicy(value v2beta1.CleanPodP
 	return b
 }
 
+// WithCleanupPolicy sets the CleanupPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CleanupPolicy field is set to the value of the last call.
+func (b *RunPolicyApplyConfiguration) WithCleanupPolicy(value *CleanupPolicyApplyConfiguration) *RunPolicyApplyConfiguration {
+	b.CleanupPolicy = value
+	return b
+}
+
 // WithTTLSecondsAfterFinished sets the TTLSecondsAfterFinished field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TTLSecondsAfterFinished field is set to the value of the last call.
@@ -70,6 +84,35 @@ func (b *RunPolicyApplyConfiguration) WithBackoffLimit(value int32) *RunPolicyAp
 	return b
 }
 
+// WithRestartMode sets the RestartMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartMode field is set to the value of the last call.
+func (b *RunPolicyApplyConfiguration) WithRestartMode(value v2beta1.RestartMode) *RunPolicyApplyConfiguration {
+	b.RestartMode = &value
+	return b
+}
+
+// WithNodeFailureLimit sets the NodeFailureLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeFailureLimit field is set to the value of the last call.
+func (b *RunPolicyApplyConfiguration) WithNodeFailureLimit(value int32) *RunPolicyApplyConfiguration {
+	b.NodeFailureLimit = &value
+	return b
+}
+
+// WithFailurePolicy adds the given value to the FailurePolicy field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FailurePolicy field.
+func (b *RunPolicyApplyConfiguration) WithFailurePolicy(values ...*FailurePolicyRuleApplyConfiguration) *RunPolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFailurePolicy")
+		}
+		b.FailurePolicy = append(b.FailurePolicy, *values[i])
+	}
+	return b
+}
+
 // WithSchedulingPolicy sets the SchedulingPolicy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SchedulingPolicy field is set to the value of the last call.
@@ -86,6 +129,22 @@ func (b *RunPolicyApplyConfiguration) WithSuspend(value bool) *RunPolicyApplyCon
 	return b
 }
 
+// WithSuspendGracePeriodSeconds sets the SuspendGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendGracePeriodSeconds field is set to the value of the last call.
+func (b *RunPolicyApplyConfiguration) WithSuspendGracePeriodSeconds(value int32) *RunPolicyApplyConfiguration {
+	b.SuspendGracePeriodSeconds = &value
+	return b
+}
+
+// WithDebugHoldSeconds sets the DebugHoldSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DebugHoldSeconds field is set to the value of the last call.
+func (b *RunPolicyApplyConfiguration) WithDebugHoldSeconds(value int32) *RunPolicyApplyConfiguration {
+	b.DebugHoldSeconds = &value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
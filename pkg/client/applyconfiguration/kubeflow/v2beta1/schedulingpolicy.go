@@ -17,17 +17,20 @@
 package v2beta1
 
 import (
+	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	v1 "k8s.io/api/core/v1"
 )
 
 // SchedulingPolicyApplyConfiguration represents a declarative configuration of the SchedulingPolicy type for use
 // with apply.
 type SchedulingPolicyApplyConfiguration struct {
-	MinAvailable           *int32           `json:"minAvailable,omitempty"`
-	Queue                  *string          `json:"queue,omitempty"`
-	MinResources           *v1.ResourceList `json:"minResources,omitempty"`
-	PriorityClass          *string          `json:"priorityClass,omitempty"`
-	ScheduleTimeoutSeconds *int32           `json:"scheduleTimeoutSeconds,omitempty"`
+	MinAvailable           *int32                         `json:"minAvailable,omitempty"`
+	Queue                  *string                        `json:"queue,omitempty"`
+	MinResources           *v1.ResourceList               `json:"minResources,omitempty"`
+	PriorityClass          *string                        `json:"priorityClass,omitempty"`
+	ScheduleTimeoutSeconds *int32                         `json:"scheduleTimeoutSeconds,omitempty"`
+	ScheduleTimeoutAction  *v2beta1.ScheduleTimeoutAction `json:"scheduleTimeoutAction,omitempty"`
+	TopologyKey            *string                        `json:"topologyKey,omitempty"`
 }
 
 // SchedulingPolicyApplyConfiguration constructs a declarative configuration of the SchedulingPolicy type for use with
@@ -75,3 +78,19 @@ func (b *SchedulingPolicyApplyConfiguration) WithScheduleTimeoutSeconds(value in
 	b.ScheduleTimeoutSeconds = &value
 	return b
 }
+
+// WithScheduleTimeoutAction sets the ScheduleTimeoutAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScheduleTimeoutAction field is set to the value of the last call.
+func (b *SchedulingPolicyApplyConfiguration) WithScheduleTimeoutAction(value v2beta1.ScheduleTimeoutAction) *SchedulingPolicyApplyConfiguration {
+	b.ScheduleTimeoutAction = &value
+	return b
+}
+
+// WithTopologyKey sets the TopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyKey field is set to the value of the last call.
+func (b *SchedulingPolicyApplyConfiguration) WithTopologyKey(value string) *SchedulingPolicyApplyConfiguration {
+	b.TopologyKey = &value
+	return b
+}
@@ -17,8 +17,10 @@
 package applyconfiguration
 
 import (
+	v1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
 	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	internal "github.com/coreweave/group-operator/pkg/client/applyconfiguration/internal"
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/client/applyconfiguration/kubeflow/v1"
 	kubeflowv2beta1 "github.com/coreweave/group-operator/pkg/client/applyconfiguration/kubeflow/v2beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,7 +31,39 @@ import (
 // apply configuration type exists for the given GroupVersionKind.
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
-	// Group=kubeflow.org, Version=v2beta1
+	// Group=coreweave.com, Version=v1
+	case v1.SchemeGroupVersion.WithKind("CleanupPolicy"):
+		return &kubeflowv1.CleanupPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FailurePolicyRule"):
+		return &kubeflowv1.FailurePolicyRuleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GroupJob"):
+		return &kubeflowv1.GroupJobApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GroupJobSpec"):
+		return &kubeflowv1.GroupJobSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("JobCondition"):
+		return &kubeflowv1.JobConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("JobStatus"):
+		return &kubeflowv1.JobStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NodeFailure"):
+		return &kubeflowv1.NodeFailureApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReplicaSpec"):
+		return &kubeflowv1.ReplicaSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReplicaStatus"):
+		return &kubeflowv1.ReplicaStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RunPolicy"):
+		return &kubeflowv1.RunPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SchedulingPolicy"):
+		return &kubeflowv1.SchedulingPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupSpec"):
+		return &kubeflowv1.WorkerGroupSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupStatus"):
+		return &kubeflowv1.WorkerGroupStatusApplyConfiguration{}
+
+		// Group=kubeflow.org, Version=v2beta1
+	case v2beta1.SchemeGroupVersion.WithKind("CleanupPolicy"):
+		return &kubeflowv2beta1.CleanupPolicyApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("FailurePolicyRule"):
+		return &kubeflowv2beta1.FailurePolicyRuleApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("JobCondition"):
 		return &kubeflowv2beta1.JobConditionApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("JobStatus"):
@@ -38,6 +72,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kubeflowv2beta1.GroupJobApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("GroupJobSpec"):
 		return &kubeflowv2beta1.GroupJobSpecApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("NodeFailure"):
+		return &kubeflowv2beta1.NodeFailureApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("ReplicaSpec"):
 		return &kubeflowv2beta1.ReplicaSpecApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("ReplicaStatus"):
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/client/clientset/versioned/typed/kubeflow/v1"
 	kubeflowv2beta1 "github.com/coreweave/group-operator/pkg/client/clientset/versioned/typed/kubeflow/v2beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
@@ -28,15 +29,22 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	KubeflowV1() kubeflowv1.KubeflowV1Interface
 	KubeflowV2beta1() kubeflowv2beta1.KubeflowV2beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	kubeflowV1      *kubeflowv1.KubeflowV1Client
 	kubeflowV2beta1 *kubeflowv2beta1.KubeflowV2beta1Client
 }
 
+// KubeflowV1 retrieves the KubeflowV1Client
+func (c *Clientset) KubeflowV1() kubeflowv1.KubeflowV1Interface {
+	return c.kubeflowV1
+}
+
 // KubeflowV2beta1 retrieves the KubeflowV2beta1Client
 func (c *Clientset) KubeflowV2beta1() kubeflowv2beta1.KubeflowV2beta1Interface {
 	return c.kubeflowV2beta1
@@ -86,6 +94,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.kubeflowV1, err = kubeflowv1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.kubeflowV2beta1, err = kubeflowv2beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -111,6 +123,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.kubeflowV1 = kubeflowv1.New(c)
 	cs.kubeflowV2beta1 = kubeflowv2beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
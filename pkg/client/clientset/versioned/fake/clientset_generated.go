@@ -19,6 +19,8 @@ package fake
 import (
 	applyconfiguration "github.com/coreweave/group-operator/pkg/client/applyconfiguration"
 	clientset "github.com/coreweave/group-operator/pkg/client/clientset/versioned"
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/client/clientset/versioned/typed/kubeflow/v1"
+	fakekubeflowv1 "github.com/coreweave/group-operator/pkg/client/clientset/versioned/typed/kubeflow/v1/fake"
 	kubeflowv2beta1 "github.com/coreweave/group-operator/pkg/client/clientset/versioned/typed/kubeflow/v2beta1"
 	fakekubeflowv2beta1 "github.com/coreweave/group-operator/pkg/client/clientset/versioned/typed/kubeflow/v2beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -114,6 +116,11 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// KubeflowV1 retrieves the KubeflowV1Client
+func (c *Clientset) KubeflowV1() kubeflowv1.KubeflowV1Interface {
+	return &fakekubeflowv1.FakeKubeflowV1{Fake: &c.Fake}
+}
+
 // KubeflowV2beta1 retrieves the KubeflowV2beta1Client
 func (c *Clientset) KubeflowV2beta1() kubeflowv2beta1.KubeflowV2beta1Interface {
 	return &fakekubeflowv2beta1.FakeKubeflowV2beta1{Fake: &c.Fake}
@@ -17,6 +17,7 @@
 package fake
 
 import (
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
 	kubeflowv2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -29,6 +30,7 @@ var scheme = runtime.NewScheme()
 var codecs = serializer.NewCodecFactory(scheme)
 
 var localSchemeBuilder = runtime.SchemeBuilder{
+	kubeflowv1.AddToScheme,
 	kubeflowv2beta1.AddToScheme,
 }
 
@@ -23,6 +23,7 @@ import (
 
 	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	kubeflowv2beta1 "github.com/coreweave/group-operator/pkg/client/applyconfiguration/kubeflow/v2beta1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -193,3 +194,27 @@ func (c *FakeGroupJobs) ApplyStatus(ctx context.Context, mPIJob *kubeflowv2beta1
 	}
 	return obj.(*v2beta1.GroupJob), err
 }
+
+// GetScale takes name of the groupJob, and returns the corresponding scale object, and an error if there is any.
+func (c *FakeGroupJobs) GetScale(ctx context.Context, groupJobName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(groupjobsResource, c.ns, "scale", groupJobName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
+
+// UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *FakeGroupJobs) UpdateScale(ctx context.Context, groupJobName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(groupjobsResource, "scale", c.ns, scale, opts), &autoscalingv1.Scale{})
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
@@ -22,6 +22,7 @@ import (
 	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	kubeflowv2beta1 "github.com/coreweave/group-operator/pkg/client/applyconfiguration/kubeflow/v2beta1"
 	scheme "github.com/coreweave/group-operator/pkg/client/clientset/versioned/scheme"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -49,6 +50,9 @@ type GroupJobInterface interface {
 	Apply(ctx context.Context, mPIJob *kubeflowv2beta1.GroupJobApplyConfiguration, opts v1.ApplyOptions) (result *v2beta1.GroupJob, err error)
 	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
 	ApplyStatus(ctx context.Context, mPIJob *kubeflowv2beta1.GroupJobApplyConfiguration, opts v1.ApplyOptions) (result *v2beta1.GroupJob, err error)
+	GetScale(ctx context.Context, groupJobName string, options v1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, groupJobName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
+
 	GroupJobExpansion
 }
 
@@ -69,3 +73,32 @@ func newGroupJobs(c *KubeflowV2beta1Client, namespace string) *mPIJobs {
 			func() *v2beta1.GroupJobList { return &v2beta1.GroupJobList{} }),
 	}
 }
+
+// GetScale takes name of the groupJob, and returns the corresponding autoscalingv1.Scale object, and an error if there is any.
+func (c *mPIJobs) GetScale(ctx context.Context, groupJobName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Get().
+		Namespace(c.GetNamespace()).
+		Resource("groupjobs").
+		Name(groupJobName).
+		SubResource("scale").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *mPIJobs) UpdateScale(ctx context.Context, groupJobName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Put().
+		Namespace(c.GetNamespace()).
+		Resource("groupjobs").
+		Name(groupJobName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
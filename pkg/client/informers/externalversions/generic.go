@@ -19,6 +19,7 @@ package externalversions
 import (
 	"fmt"
 
+	v1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
 	v2beta1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
@@ -50,7 +51,11 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=kubeflow.org, Version=v2beta1
+	// Group=coreweave.com, Version=v1
+	case v1.SchemeGroupVersion.WithResource("groupjobs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V1().GroupJobs().Informer()}, nil
+
+		// Group=kubeflow.org, Version=v2beta1
 	case v2beta1.SchemeGroupVersion.WithResource("groupjobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubeflow().V2beta1().GroupJobs().Informer()}, nil
 
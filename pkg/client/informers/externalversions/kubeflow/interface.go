@@ -18,11 +18,14 @@ package kubeflow
 
 import (
 	internalinterfaces "github.com/coreweave/group-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1 "github.com/coreweave/group-operator/pkg/client/informers/externalversions/kubeflow/v1"
 	v2beta1 "github.com/coreweave/group-operator/pkg/client/informers/externalversions/kubeflow/v2beta1"
 )
 
 // Interface provides access to each of this group's versions.
 type Interface interface {
+	// V1 provides access to shared informers for resources in V1.
+	V1() v1.Interface
 	// V2beta1 provides access to shared informers for resources in V2beta1.
 	V2beta1() v2beta1.Interface
 }
@@ -38,6 +41,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &group{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// V1 returns a new v1.Interface.
+func (g *group) V1() v1.Interface {
+	return v1.New(g.factory, g.namespace, g.tweakListOptions)
+}
+
 // V2beta1 returns a new v2beta1.Interface.
 func (g *group) V2beta1() v2beta1.Interface {
 	return v2beta1.New(g.factory, g.namespace, g.tweakListOptions)
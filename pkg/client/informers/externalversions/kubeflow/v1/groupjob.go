@@ -0,0 +1,88 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+	versioned "github.com/coreweave/group-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/coreweave/group-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1 "github.com/coreweave/group-operator/pkg/client/listers/kubeflow/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// GroupJobInformer provides access to a shared informer and lister for
+// GroupJobs.
+type GroupJobInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.GroupJobLister
+}
+
+type groupJobInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewGroupJobInformer constructs a new informer for GroupJob type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewGroupJobInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredGroupJobInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredGroupJobInformer constructs a new informer for GroupJob type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredGroupJobInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KubeflowV1().GroupJobs(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KubeflowV1().GroupJobs(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&kubeflowv1.GroupJob{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *groupJobInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredGroupJobInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *groupJobInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&kubeflowv1.GroupJob{}, f.defaultInformer)
+}
+
+func (f *groupJobInformer) Lister() v1.GroupJobLister {
+	return v1.NewGroupJobLister(f.Informer().GetIndexer())
+}
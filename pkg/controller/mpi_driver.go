@@ -0,0 +1,202 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	openMPISlotsEnv  = "OMPI_MCA_orte_set_default_slots"
+	intelMPISlotsEnv = "I_MPI_PERHOST"
+)
+
+// MPIDriver produces the parts of the hostfile and of the launcher that are
+// specific to an MPI implementation.
+type MPIDriver interface {
+	// HostfileLine returns the hostfile line for a host running slots
+	// processes, including the trailing newline.
+	HostfileLine(host string, slots int32) string
+	// HostfileEnv returns the environment variables pointing the launcher to
+	// the hostfile at path.
+	HostfileEnv(path string) []corev1.EnvVar
+	// BootstrapEnv returns the environment variables passing args to the
+	// remote shell the launcher starts the processes on the workers with.
+	BootstrapEnv(args string) []corev1.EnvVar
+	// ExecBootstrapEnv returns the environment variables making the launcher
+	// start the processes on the workers with the rsh agent at path.
+	ExecBootstrapEnv(agent string) []corev1.EnvVar
+	// SlotsEnv returns the environment variables setting the default number
+	// of processes per host. It returns nil if the implementation has none.
+	SlotsEnv(slots int32) []corev1.EnvVar
+	// ContainerEnv returns the environment variables the implementation needs
+	// to run in containers. The launcher passes them on to the processes it
+	// starts. It returns nil if the implementation needs none.
+	ContainerEnv() []corev1.EnvVar
+}
+
+// mpiDrivers is the registry of the supported MPI implementations.
+var mpiDrivers = map[kubeflow.MPIImplementation]MPIDriver{
+	kubeflow.MPIImplementationOpenMPI:   openMPIDriver{},
+	kubeflow.MPIImplementationIntel:     intelMPIDriver{},
+	kubeflow.MPIImplementationMPICH:     hydraDriver{},
+	kubeflow.MPIImplementationMVAPICH2:  mvapich2Driver{},
+	kubeflow.MPIImplementationCrayMPICH: crayMPICHDriver{},
+}
+
+// launcherMPIEnvVars returns the environment variables the launcher needs to
+// start the processes of mpiJob with its MPI implementation.
+func launcherMPIEnvVars(mpiJob *kubeflow.GroupJob) []corev1.EnvVar {
+	driver, ok := mpiDrivers[mpiJob.Spec.MPIImplementation]
+	if !ok {
+		return nil
+	}
+	var env []corev1.EnvVar
+	env = append(env, driver.HostfileEnv(fmt.Sprintf("%s/%s", configMountPath, hostfileName))...)
+	if isExecBootstrap(mpiJob) {
+		env = append(env, driver.ExecBootstrapEnv(fmt.Sprintf("%s/%s", configMountPath, execAgentScriptName))...)
+	} else {
+		env = append(env, driver.BootstrapEnv(sshBootstrapArgs(mpiJob))...)
+	}
+	env = append(env, driver.SlotsEnv(*mpiJob.Spec.SlotsPerWorker)...)
+	env = append(env, driver.ContainerEnv()...)
+	return env
+}
+
+// openMPIDriver drives Open MPI through its ORTE/PRRTE runtime.
+type openMPIDriver struct{}
+
+func (openMPIDriver) HostfileLine(host string, slots int32) string {
+	return fmt.Sprintf("%s slots=%d\n", host, slots)
+}
+
+func (openMPIDriver) HostfileEnv(path string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		// Allows driver to reach workers through the Service.
+		{Name: "OMPI_MCA_orte_keep_fqdn_hostnames", Value: "true"},
+		{Name: "OMPI_MCA_orte_default_hostfile", Value: path},
+	}
+}
+
+func (openMPIDriver) BootstrapEnv(args string) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "OMPI_MCA_plm_rsh_args", Value: args}}
+}
+
+func (openMPIDriver) ExecBootstrapEnv(agent string) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "OMPI_MCA_plm_rsh_agent", Value: agent}}
+}
+
+func (openMPIDriver) SlotsEnv(slots int32) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: openMPISlotsEnv, Value: strconv.Itoa(int(slots))}}
+}
+
+func (openMPIDriver) ContainerEnv() []corev1.EnvVar {
+	return nil
+}
+
+// intelMPIDriver drives Intel MPI through its own build of Hydra.
+type intelMPIDriver struct{}
+
+func (intelMPIDriver) HostfileLine(host string, slots int32) string {
+	return fmt.Sprintf("%s:%d\n", host, slots)
+}
+
+func (intelMPIDriver) HostfileEnv(path string) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "I_MPI_HYDRA_HOST_FILE", Value: path}}
+}
+
+func (intelMPIDriver) BootstrapEnv(args string) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "I_MPI_HYDRA_BOOTSTRAP_EXEC_EXTRA_ARGS", Value: args}}
+}
+
+func (intelMPIDriver) ExecBootstrapEnv(agent string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "I_MPI_HYDRA_BOOTSTRAP", Value: "rsh"},
+		{Name: "I_MPI_HYDRA_BOOTSTRAP_EXEC", Value: agent},
+	}
+}
+
+func (intelMPIDriver) SlotsEnv(slots int32) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: intelMPISlotsEnv, Value: strconv.Itoa(int(slots))}}
+}
+
+func (intelMPIDriver) ContainerEnv() []corev1.EnvVar {
+	return nil
+}
+
+// hydraDriver drives MPICH through the upstream Hydra process manager, which
+// takes the slots from the hostfile only.
+type hydraDriver struct{}
+
+func (hydraDriver) HostfileLine(host string, slots int32) string {
+	return fmt.Sprintf("%s:%d\n", host, slots)
+}
+
+func (hydraDriver) HostfileEnv(path string) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "HYDRA_HOST_FILE", Value: path}}
+}
+
+func (hydraDriver) BootstrapEnv(args string) []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "HYDRA_LAUNCH_EXTRA_ARGS", Value: args}}
+}
+
+func (hydraDriver) ExecBootstrapEnv(agent string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "HYDRA_LAUNCHER", Value: "rsh"},
+		{Name: "HYDRA_LAUNCHER_EXEC", Value: agent},
+	}
+}
+
+func (hydraDriver) SlotsEnv(int32) []corev1.EnvVar {
+	return nil
+}
+
+func (hydraDriver) ContainerEnv() []corev1.EnvVar {
+	return nil
+}
+
+// mvapich2Driver drives MVAPICH2 through the build of Hydra it ships as
+// mpirun. MVAPICH2 pins its processes to cores on its own, which fails or
+// oversubscribes the cores of a pod limited to a cpuset, and its shared memory
+// channel reads the memory of the other processes through cross memory
+// attach, which needs the ptrace permission containers don't get.
+type mvapich2Driver struct {
+	hydraDriver
+}
+
+func (mvapich2Driver) ContainerEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "MV2_ENABLE_AFFINITY", Value: "0"},
+		{Name: "MV2_SMP_USE_CMA", Value: "0"},
+	}
+}
+
+// crayMPICHDriver drives Cray MPICH through the upstream Hydra process
+// manager, since the PALS launcher of Cray systems isn't available in a
+// cluster. Cray MPICH moves the messages between the processes of a host
+// through XPMEM by default, which needs a kernel module and a device
+// containers don't get.
+type crayMPICHDriver struct {
+	hydraDriver
+}
+
+func (crayMPICHDriver) ContainerEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{{Name: "MPICH_SMP_SINGLE_COPY_MODE", Value: "NONE"}}
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// The environment variables the launcher got before the MPI drivers were
+// introduced. They must not change.
+var (
+	ompiEnvVars = []corev1.EnvVar{
+		{Name: "OMPI_MCA_orte_keep_fqdn_hostnames", Value: "true"},
+		{Name: "OMPI_MCA_orte_default_hostfile", Value: "/etc/mpi/hostfile"},
+		{Name: "OMPI_MCA_plm_rsh_args", Value: "-o ConnectionAttempts=10"},
+	}
+	intelEnvVars = []corev1.EnvVar{
+		{Name: "I_MPI_HYDRA_HOST_FILE", Value: "/etc/mpi/hostfile"},
+		{Name: "I_MPI_HYDRA_BOOTSTRAP_EXEC_EXTRA_ARGS", Value: "-o ConnectionAttempts=10"},
+	}
+	hydraEnvVars = []corev1.EnvVar{
+		{Name: "HYDRA_HOST_FILE", Value: "/etc/mpi/hostfile"},
+		{Name: "HYDRA_LAUNCH_EXTRA_ARGS", Value: "-o ConnectionAttempts=10"},
+	}
+)
+
+func TestMPIDrivers(t *testing.T) {
+	testCases := map[kubeflow.MPIImplementation]struct {
+		wantHostfile string
+		wantEnv      []corev1.EnvVar
+	}{
+		kubeflow.MPIImplementationOpenMPI: {
+			wantHostfile: "foo-launcher.foo.tenant-a.svc slots=4\nfoo-worker-0.foo.tenant-a.svc slots=4\nfoo-worker-1.foo.tenant-a.svc slots=4\n",
+			wantEnv:      joinEnvVars(ompiEnvVars, corev1.EnvVar{Name: "OMPI_MCA_orte_set_default_slots", Value: "4"}),
+		},
+		kubeflow.MPIImplementationIntel: {
+			wantHostfile: "foo-launcher.foo.tenant-a.svc:4\nfoo-worker-0.foo.tenant-a.svc:4\nfoo-worker-1.foo.tenant-a.svc:4\n",
+			wantEnv:      joinEnvVars(intelEnvVars, corev1.EnvVar{Name: "I_MPI_PERHOST", Value: "4"}),
+		},
+		kubeflow.MPIImplementationMPICH: {
+			wantHostfile: "foo-launcher.foo.tenant-a.svc:4\nfoo-worker-0.foo.tenant-a.svc:4\nfoo-worker-1.foo.tenant-a.svc:4\n",
+			wantEnv:      hydraEnvVars,
+		},
+		kubeflow.MPIImplementationMVAPICH2: {
+			wantHostfile: "foo-launcher.foo.tenant-a.svc:4\nfoo-worker-0.foo.tenant-a.svc:4\nfoo-worker-1.foo.tenant-a.svc:4\n",
+			wantEnv: joinEnvVars(hydraEnvVars,
+				corev1.EnvVar{Name: "MV2_ENABLE_AFFINITY", Value: "0"},
+				corev1.EnvVar{Name: "MV2_SMP_USE_CMA", Value: "0"}),
+		},
+		kubeflow.MPIImplementationCrayMPICH: {
+			wantHostfile: "foo-launcher.foo.tenant-a.svc:4\nfoo-worker-0.foo.tenant-a.svc:4\nfoo-worker-1.foo.tenant-a.svc:4\n",
+			wantEnv:      joinEnvVars(hydraEnvVars, corev1.EnvVar{Name: "MPICH_SMP_SINGLE_COPY_MODE", Value: "NONE"}),
+		},
+	}
+	if len(testCases) != len(mpiDrivers) {
+		t.Errorf("Got %d registered MPI drivers, want %d", len(mpiDrivers), len(testCases))
+	}
+	for implementation, tc := range testCases {
+		t.Run(string(implementation), func(t *testing.T) {
+			mpiJob := &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker:      ptr.To[int32](4),
+					RunLauncherAsWorker: ptr.To(true),
+					MPIImplementation:   implementation,
+				},
+			}
+			cm := newConfigMap(mpiJob, 2)
+			if diff := cmp.Diff(tc.wantHostfile, cm.Data[hostfileName]); diff != "" {
+				t.Errorf("Unexpected hostfile (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEnv, launcherMPIEnvVars(mpiJob)); diff != "" {
+				t.Errorf("Unexpected launcher environment (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
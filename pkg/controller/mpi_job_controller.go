@@ -17,16 +17,23 @@ package controller
 import (
 	"bytes"
 	"context"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -39,15 +46,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	batchinformers "k8s.io/client-go/informers/batch/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	rbacinformers "k8s.io/client-go/informers/rbac/v1"
 	schedulinginformers "k8s.io/client-go/informers/scheduling/v1"
 	"k8s.io/client-go/kubernetes"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	batchlisters "k8s.io/client-go/listers/batch/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	rbaclisters "k8s.io/client-go/listers/rbac/v1"
 	schedulinglisters "k8s.io/client-go/listers/scheduling/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -58,7 +71,7 @@ import (
 	schedclientset "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned"
 	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned"
 
-	"github.com/coreweave/group-operator/cmd/group-operator/app/options"
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
 	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
 	"github.com/coreweave/group-operator/pkg/apis/kubeflow/validation"
 	clientset "github.com/coreweave/group-operator/pkg/client/clientset/versioned"
@@ -88,6 +101,11 @@ const (
 	sshPrivateKeyFile       = "id_rsa"
 	sshPublicKeyFile        = sshPrivateKeyFile + ".pub"
 	sshAuthorizedKeysFile   = "authorized_keys"
+	sshHostPrivateKey       = "ssh-host-privatekey"
+	sshHostPublicKey        = "ssh-host-publickey"
+	sshHostKeyFile          = "ssh_host_key"
+	sshKnownHosts           = "ssh-known-hosts"
+	sshKnownHostsFile       = "known_hosts"
 )
 
 const (
@@ -112,8 +130,9 @@ const (
 	// From: k8s.io/kubernetes/pkg/apis/core/validation/events.go
 	eventMessageLimit = 1024
 
-	openMPISlotsEnv  = "OMPI_MCA_orte_set_default_slots"
-	intelMPISlotsEnv = "I_MPI_PERHOST"
+	// defaultBackoffLimit is the maximum number of restarts when
+	// RunPolicy.BackoffLimit is unset. It matches the default of batch/v1 Jobs.
+	defaultBackoffLimit = 6
 )
 
 var (
@@ -125,6 +144,10 @@ var (
 		Name: "group_operator_jobs_successful_total",
 		Help: "Counts number of Group jobs successful",
 	})
+	mpiJobsRestartCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "group_operator_jobs_restarted_total",
+		Help: "Counts number of Group job restarts",
+	})
 	mpiJobsFailureCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "group_operator_jobs_failed_total",
 		Help: "Counts number of Group jobs failed",
@@ -134,7 +157,9 @@ var (
 		Help: "Information about GroupJob",
 	}, []string{"launcher", "namespace"})
 
-	sshVolumeItems = []corev1.KeyToPath{
+	// sshClientVolumeItems are the entries mounted from a Secret referenced by
+	// spec.sshAuthSecretName.
+	sshClientVolumeItems = []corev1.KeyToPath{
 		{
 			Key:  corev1.SSHAuthPrivateKey,
 			Path: sshPrivateKeyFile,
@@ -148,6 +173,27 @@ var (
 			Path: sshAuthorizedKeysFile,
 		},
 	}
+	// sshVolumeItems are the entries mounted from the Secret generated for the
+	// GroupJob.
+	sshVolumeItems = append(slices.Clip(sshClientVolumeItems),
+		corev1.KeyToPath{
+			Key:  sshHostPrivateKey,
+			Path: sshHostKeyFile,
+		},
+		corev1.KeyToPath{
+			Key:  sshKnownHosts,
+			Path: sshKnownHostsFile,
+		},
+	)
+	// sshAuthSecretKeys are the sorted entries of the Secret generated for the
+	// GroupJob.
+	sshAuthSecretKeys = []string{
+		sshHostPrivateKey,
+		sshHostPublicKey,
+		sshKnownHosts,
+		corev1.SSHAuthPrivateKey,
+		sshPublicKey,
+	}
 	configVolumeItems = []corev1.KeyToPath{
 		{
 			Key:  hostfileName,
@@ -173,41 +219,6 @@ var (
 			Value: worker,
 		},
 	}
-	ompiEnvVars = []corev1.EnvVar{
-		// Allows driver to reach workers through the Service.
-		{
-			Name:  "OMPI_MCA_orte_keep_fqdn_hostnames",
-			Value: "true",
-		},
-		{
-			Name:  "OMPI_MCA_orte_default_hostfile",
-			Value: fmt.Sprintf("%s/%s", configMountPath, hostfileName),
-		},
-		{
-			Name:  "OMPI_MCA_plm_rsh_args",
-			Value: "-o ConnectionAttempts=10",
-		},
-	}
-	intelEnvVars = []corev1.EnvVar{
-		{
-			Name:  "I_MPI_HYDRA_HOST_FILE",
-			Value: fmt.Sprintf("%s/%s", configMountPath, hostfileName),
-		},
-		{
-			Name:  "I_MPI_HYDRA_BOOTSTRAP_EXEC_EXTRA_ARGS",
-			Value: "-o ConnectionAttempts=10",
-		},
-	}
-	mpichEnvVars = []corev1.EnvVar{
-		{
-			Name:  "HYDRA_HOST_FILE",
-			Value: fmt.Sprintf("%s/%s", configMountPath, hostfileName),
-		},
-		{
-			Name:  "HYDRA_LAUNCH_EXTRA_ARGS",
-			Value: "-o ConnectionAttempts=10",
-		},
-	}
 	nvidiaDisableEnvVars = []corev1.EnvVar{
 		{Name: "NVIDIA_VISIBLE_DEVICES"},
 		{Name: "NVIDIA_DRIVER_CAPABILITIES"},
@@ -222,22 +233,33 @@ type GroupJobController struct {
 	kubeflowClient clientset.Interface
 	// PodGroupCtrl is a client for PodGroups (volcano and scheduler-plugins).
 	PodGroupCtrl PodGroupControl
-
-	configMapLister     corelisters.ConfigMapLister
-	configMapSynced     cache.InformerSynced
-	secretLister        corelisters.SecretLister
-	secretSynced        cache.InformerSynced
-	serviceLister       corelisters.ServiceLister
-	serviceSynced       cache.InformerSynced
-	jobLister           batchlisters.JobLister
-	jobSynced           cache.InformerSynced
-	podLister           corelisters.PodLister
-	podSynced           cache.InformerSynced
-	podGroupSynced      cache.InformerSynced
-	priorityClassLister schedulinglisters.PriorityClassLister
-	priorityClassSynced cache.InformerSynced
-	mpiJobLister        listers.GroupJobLister
-	mpiJobSynced        cache.InformerSynced
+	// QueueConfig configures the queue of the GroupJobs. Queueing is
+	// disabled when nil.
+	QueueConfig *QueueConfig
+
+	configMapLister      corelisters.ConfigMapLister
+	configMapSynced      cache.InformerSynced
+	secretLister         corelisters.SecretLister
+	secretSynced         cache.InformerSynced
+	serviceLister        corelisters.ServiceLister
+	serviceSynced        cache.InformerSynced
+	jobLister            batchlisters.JobLister
+	jobSynced            cache.InformerSynced
+	podLister            corelisters.PodLister
+	podSynced            cache.InformerSynced
+	serviceAccountLister corelisters.ServiceAccountLister
+	serviceAccountSynced cache.InformerSynced
+	roleLister           rbaclisters.RoleLister
+	roleSynced           cache.InformerSynced
+	roleBindingLister    rbaclisters.RoleBindingLister
+	roleBindingSynced    cache.InformerSynced
+	podGroupSynced       cache.InformerSynced
+	priorityClassLister  schedulinglisters.PriorityClassLister
+	priorityClassSynced  cache.InformerSynced
+	nodeLister           corelisters.NodeLister
+	nodeSynced           cache.InformerSynced
+	mpiJobLister         listers.GroupJobLister
+	mpiJobSynced         cache.InformerSynced
 
 	// queue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
@@ -267,13 +289,18 @@ func NewGroupJobController(
 	serviceInformer coreinformers.ServiceInformer,
 	jobInformer batchinformers.JobInformer,
 	podInformer coreinformers.PodInformer,
+	serviceAccountInformer coreinformers.ServiceAccountInformer,
+	roleInformer rbacinformers.RoleInformer,
+	roleBindingInformer rbacinformers.RoleBindingInformer,
 	priorityClassInformer schedulinginformers.PriorityClassInformer,
+	nodeInformer coreinformers.NodeInformer,
 	mpiJobInformer informers.GroupJobInformer,
 	namespace, gangSchedulingName string,
 	workqueueRateLimiter workqueue.TypedRateLimiter[any]) (*GroupJobController, error) {
 	return NewGroupJobControllerWithClock(kubeClient, kubeflowClient, volcanoClient, schedClient,
 		configMapInformer, secretInformer, serviceInformer, jobInformer, podInformer,
-		priorityClassInformer, mpiJobInformer, &clock.RealClock{}, namespace, gangSchedulingName, workqueueRateLimiter)
+		serviceAccountInformer, roleInformer, roleBindingInformer, priorityClassInformer, nodeInformer, mpiJobInformer,
+		&clock.RealClock{}, namespace, gangSchedulingName, workqueueRateLimiter)
 }
 
 // NewGroupJobControllerWithClock returns a new GroupJob controller.
@@ -287,7 +314,11 @@ func NewGroupJobControllerWithClock(
 	serviceInformer coreinformers.ServiceInformer,
 	jobInformer batchinformers.JobInformer,
 	podInformer coreinformers.PodInformer,
+	serviceAccountInformer coreinformers.ServiceAccountInformer,
+	roleInformer rbacinformers.RoleInformer,
+	roleBindingInformer rbacinformers.RoleBindingInformer,
 	priorityClassInformer schedulinginformers.PriorityClassInformer,
+	nodeInformer coreinformers.NodeInformer,
 	mpiJobInformer informers.GroupJobInformer,
 	clock clock.WithTicker,
 	namespace, gangSchedulingName string,
@@ -309,38 +340,50 @@ func NewGroupJobControllerWithClock(
 	)
 	priorityClassLister = priorityClassInformer.Lister()
 	priorityClassSynced = priorityClassInformer.Informer().HasSynced
-	if gangSchedulingName == options.GangSchedulerVolcano {
-		podGroupCtrl = NewVolcanoCtrl(volcanoClient, namespace, priorityClassLister)
-	} else if len(gangSchedulingName) != 0 {
-		// Use scheduler-plugins as a default gang-scheduler.
-		podGroupCtrl = NewSchedulerPluginsCtrl(schedClient, namespace, gangSchedulingName, priorityClassLister)
-	}
+	podGroupCtrl = newPodGroupControl(podGroupControlConfig{
+		volcanoClient:       volcanoClient,
+		schedClient:         schedClient,
+		namespace:           namespace,
+		schedulerName:       gangSchedulingName,
+		priorityClassLister: priorityClassLister,
+	})
 	if podGroupCtrl != nil {
-		podGroupSynced = podGroupCtrl.PodGroupSharedIndexInformer().HasSynced
+		// Gang-schedulers without a PodGroup API have no informer.
+		if informer := podGroupCtrl.PodGroupSharedIndexInformer(); informer != nil {
+			podGroupSynced = informer.HasSynced
+		}
 	}
 
 	controller := &GroupJobController{
-		kubeClient:          kubeClient,
-		kubeflowClient:      kubeflowClient,
-		PodGroupCtrl:        podGroupCtrl,
-		configMapLister:     configMapInformer.Lister(),
-		configMapSynced:     configMapInformer.Informer().HasSynced,
-		secretLister:        secretInformer.Lister(),
-		secretSynced:        secretInformer.Informer().HasSynced,
-		serviceLister:       serviceInformer.Lister(),
-		serviceSynced:       serviceInformer.Informer().HasSynced,
-		jobLister:           jobInformer.Lister(),
-		jobSynced:           jobInformer.Informer().HasSynced,
-		podLister:           podInformer.Lister(),
-		podSynced:           podInformer.Informer().HasSynced,
-		podGroupSynced:      podGroupSynced,
-		priorityClassLister: priorityClassLister,
-		priorityClassSynced: priorityClassSynced,
-		mpiJobLister:        mpiJobInformer.Lister(),
-		mpiJobSynced:        mpiJobInformer.Informer().HasSynced,
-		queue:               workqueue.NewTypedRateLimitingQueueWithConfig(workqueueRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{Name: "GroupJob"}),
-		recorder:            recorder,
-		clock:               clock,
+		kubeClient:           kubeClient,
+		kubeflowClient:       kubeflowClient,
+		PodGroupCtrl:         podGroupCtrl,
+		configMapLister:      configMapInformer.Lister(),
+		configMapSynced:      configMapInformer.Informer().HasSynced,
+		secretLister:         secretInformer.Lister(),
+		secretSynced:         secretInformer.Informer().HasSynced,
+		serviceLister:        serviceInformer.Lister(),
+		serviceSynced:        serviceInformer.Informer().HasSynced,
+		jobLister:            jobInformer.Lister(),
+		jobSynced:            jobInformer.Informer().HasSynced,
+		podLister:            podInformer.Lister(),
+		podSynced:            podInformer.Informer().HasSynced,
+		serviceAccountLister: serviceAccountInformer.Lister(),
+		serviceAccountSynced: serviceAccountInformer.Informer().HasSynced,
+		roleLister:           roleInformer.Lister(),
+		roleSynced:           roleInformer.Informer().HasSynced,
+		roleBindingLister:    roleBindingInformer.Lister(),
+		roleBindingSynced:    roleBindingInformer.Informer().HasSynced,
+		podGroupSynced:       podGroupSynced,
+		priorityClassLister:  priorityClassLister,
+		priorityClassSynced:  priorityClassSynced,
+		nodeLister:           nodeInformer.Lister(),
+		nodeSynced:           nodeInformer.Informer().HasSynced,
+		mpiJobLister:         mpiJobInformer.Lister(),
+		mpiJobSynced:         mpiJobInformer.Informer().HasSynced,
+		queue:                workqueue.NewTypedRateLimitingQueueWithConfig(workqueueRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{Name: "GroupJob"}),
+		recorder:             recorder,
+		clock:                clock,
 	}
 
 	controller.updateStatusHandler = controller.doUpdateJobStatus
@@ -348,13 +391,16 @@ func NewGroupJobControllerWithClock(
 	// Set up error handlers for informers
 	klog.Info("Setting up informer error handlers")
 	informers := map[string]cache.SharedInformer{
-		"configMapInformer":     configMapInformer.Informer(),
-		"secretInformer":        secretInformer.Informer(),
-		"serviceInformer":       serviceInformer.Informer(),
-		"jobInformer":           jobInformer.Informer(),
-		"podInformer":           podInformer.Informer(),
-		"priorityClassInformer": priorityClassInformer.Informer(),
-		"mpiJobInformer":        mpiJobInformer.Informer(),
+		"configMapInformer":      configMapInformer.Informer(),
+		"secretInformer":         secretInformer.Informer(),
+		"serviceInformer":        serviceInformer.Informer(),
+		"jobInformer":            jobInformer.Informer(),
+		"podInformer":            podInformer.Informer(),
+		"serviceAccountInformer": serviceAccountInformer.Informer(),
+		"roleInformer":           roleInformer.Informer(),
+		"roleBindingInformer":    roleBindingInformer.Informer(),
+		"priorityClassInformer":  priorityClassInformer.Informer(),
+		"mpiJobInformer":         mpiJobInformer.Informer(),
 	}
 
 	for name, informer := range informers {
@@ -380,6 +426,7 @@ func NewGroupJobControllerWithClock(
 		UpdateFunc: func(old, new interface{}) {
 			controller.enqueueGroupJob(new)
 		},
+		DeleteFunc: controller.deleteGroupJob,
 	}); err != nil {
 		return nil, err
 	}
@@ -425,13 +472,36 @@ func NewGroupJobControllerWithClock(
 	}); err != nil {
 		return nil, err
 	}
+	if _, err := serviceAccountInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.handleObject,
+		UpdateFunc: controller.handleObjectUpdate,
+		DeleteFunc: controller.handleObject,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := roleInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.handleObject,
+		UpdateFunc: controller.handleObjectUpdate,
+		DeleteFunc: controller.handleObject,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := roleBindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.handleObject,
+		UpdateFunc: controller.handleObjectUpdate,
+		DeleteFunc: controller.handleObject,
+	}); err != nil {
+		return nil, err
+	}
 	if podGroupCtrl != nil {
-		if _, err := podGroupCtrl.PodGroupSharedIndexInformer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-			AddFunc:    controller.handleObject,
-			UpdateFunc: controller.handleObjectUpdate,
-			DeleteFunc: controller.handleObject,
-		}); err != nil {
-			return nil, err
+		if informer := podGroupCtrl.PodGroupSharedIndexInformer(); informer != nil {
+			if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc:    controller.handleObject,
+				UpdateFunc: controller.handleObjectUpdate,
+				DeleteFunc: controller.handleObject,
+			}); err != nil {
+				return nil, err
+			}
 		}
 		if _, err := priorityClassInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc:    controller.handleObject,
@@ -463,10 +533,17 @@ func (c *GroupJobController) Run(threadiness int, stopCh <-chan struct{}) error
 		c.serviceSynced,
 		c.jobSynced,
 		c.podSynced,
+		c.serviceAccountSynced,
+		c.roleSynced,
+		c.roleBindingSynced,
+		c.nodeSynced,
 		c.mpiJobSynced,
 	}
-	if c.PodGroupCtrl != nil {
-		synced = append(synced, c.podGroupSynced, c.priorityClassSynced)
+	if c.PodGroupCtrl != nil || c.QueueConfig != nil {
+		synced = append(synced, c.priorityClassSynced)
+	}
+	if c.PodGroupCtrl != nil && c.podGroupSynced != nil {
+		synced = append(synced, c.podGroupSynced)
 	}
 	if ok := cache.WaitForCacheSync(stopCh, synced...); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
@@ -605,17 +682,33 @@ func (c *GroupJobController) syncHandler(key string) error {
 		mpiJobsCreatedCount.Inc()
 	}
 
+	if failed, err := c.checkActiveDeadline(mpiJob); err != nil {
+		return err
+	} else if failed && !cleansUpFinishedWorkers(mpiJob) {
+		// Nothing is cleaned up below, so the status is updated here.
+		return c.updateStatusHandler(mpiJob)
+	}
+
+	if c.QueueConfig != nil {
+		if err := c.queueGroupJob(mpiJob); err != nil {
+			return err
+		}
+	}
+
 	// CompletionTime is only filled when the launcher Job succeeded or stopped
 	// retrying (it reached .spec.backoffLimit). If it's filled, we want to
 	// cleanup and stop retrying the GroupJob.
 	if isFinished(mpiJob.Status) && mpiJob.Status.CompletionTime != nil {
-		if isCleanUpPods(mpiJob.Spec.RunPolicy.CleanPodPolicy) {
-			if err := cleanUpWorkerPods(mpiJob, c); err != nil {
+		if isFailed(mpiJob.Status) {
+			cause := getCondition(mpiJob.Status, kubeflow.JobFailed).Message
+			if held, err := c.holdForDebugging(mpiJob, cause); held || err != nil {
 				return err
 			}
-			return c.updateStatusHandler(mpiJob)
 		}
-		return nil
+		if deleted, err := c.checkTTLAfterFinished(mpiJob); deleted || err != nil {
+			return err
+		}
+		return c.cleanUpFinishedGroupJob(mpiJob)
 	}
 
 	// first set StartTime.
@@ -630,6 +723,37 @@ func (c *GroupJobController) syncHandler(key string) error {
 		return err
 	}
 
+	if waiting, err := c.waitForCheckpoint(mpiJob, launcher); waiting || err != nil {
+		return err
+	}
+
+	if launcherHash := c.replicaSpecHash(mpiJob, kubeflow.MPIReplicaTypeLauncher); launcher != nil && !isJobFinished(launcher) && hasSpecDrift(launcher, launcherHash) {
+		if isGroupJobSuspended(mpiJob) {
+			// The launcher Job is created again from the changed template
+			// once its deletion is observed.
+			msg := fmt.Sprintf("Recreating launcher Job %s from the changed launcher template", launcher.Name)
+			c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobSpecChangeAppliedReason, msg)
+			return c.deleteLauncherJob(launcher)
+		}
+		if !isSpecChangeReported(launcher, launcherHash) {
+			msg := fmt.Sprintf("The launcher template changed, but it is not applied to the running launcher Job %s; suspend the GroupJob to recreate it", launcher.Name)
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobSpecChangeIgnoredReason, msg)
+			launcher = launcher.DeepCopy()
+			metav1.SetMetaDataAnnotation(&launcher.ObjectMeta, kubeflow.SpecChangeIgnoredAnnotation, launcherHash)
+			if launcher, err = c.kubeClient.BatchV1().Jobs(namespace).Update(context.TODO(), launcher, metav1.UpdateOptions{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Under the Group restart mode, the whole group is torn down when any of
+	// its members failed. Nothing is recreated until the teardown finished.
+	if isGroupRestartMode(mpiJob) && !isGroupJobSuspended(mpiJob) {
+		if restarting, err := c.restartFailedGroup(mpiJob, launcher); restarting || err != nil {
+			return err
+		}
+	}
+
 	var worker []*corev1.Pod
 	// We're done if the launcher either succeeded or failed.
 	done := launcher != nil && isJobFinished(launcher)
@@ -643,15 +767,22 @@ func (c *GroupJobController) syncHandler(key string) error {
 			return fmt.Errorf("getting or creating ConfigMap: %w", err)
 		}
 
-		_, err = c.getOrCreateSSHAuthSecret(mpiJob)
-		if err != nil {
-			return fmt.Errorf("creating SSH auth secret: %w", err)
+		if isExecBootstrap(mpiJob) {
+			if err := c.getOrCreateExecBootstrap(mpiJob); err != nil {
+				return err
+			}
+		} else {
+			_, err = c.getOrCreateSSHAuthSecret(mpiJob)
+			if err != nil {
+				return fmt.Errorf("creating SSH auth secret: %w", err)
+			}
 		}
 
 		if !isGroupJobSuspended(mpiJob) {
 			// Get the PodGroup for this GroupJob
+			var podGroup metav1.Object
 			if c.PodGroupCtrl != nil {
-				if podGroup, err := c.getOrCreatePodGroups(mpiJob); podGroup == nil || err != nil {
+				if podGroup, err = c.getOrCreatePodGroups(mpiJob); err != nil {
 					return err
 				}
 			}
@@ -659,9 +790,19 @@ func (c *GroupJobController) syncHandler(key string) error {
 			if err != nil {
 				return err
 			}
+			if c.PodGroupCtrl != nil {
+				c.updatePodGroupStatus(mpiJob, podGroup, worker)
+			}
 		}
 		if launcher == nil {
-			if mpiJob.Spec.LauncherCreationPolicy == kubeflow.LauncherCreationPolicyAtStartup || c.countReadyWorkerPods(worker) == len(worker) {
+			if mpiJob.Spec.LauncherCreationPolicy == kubeflow.LauncherCreationPolicyAtStartup || c.countReadyWorkerPods(worker) >= workersNeeded(mpiJob, worker) {
+				// Submit a warning event if the user specifies restart policy for
+				// the pod template. We recommend to set it from the replica level.
+				if mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.RestartPolicy != "" {
+					errMsg := "Restart policy in pod template overridden by restart policy in replica spec"
+					klog.Warning(errMsg)
+					c.recorder.Event(mpiJob, corev1.EventTypeWarning, podTemplateRestartPolicyReason, errMsg)
+				}
 				launcher, err = c.kubeClient.BatchV1().Jobs(namespace).Create(context.TODO(), c.newLauncherJob(mpiJob), metav1.CreateOptions{})
 				if err != nil {
 					c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, mpiJobFailedReason, "launcher pod created failed: %v", err)
@@ -673,6 +814,10 @@ func (c *GroupJobController) syncHandler(key string) error {
 		}
 	}
 
+	if err := c.checkScheduleTimeout(mpiJob, launcher, worker); err != nil {
+		return err
+	}
+
 	if launcher != nil {
 		if isGroupJobSuspended(mpiJob) != isJobSuspended(launcher) {
 			// align the suspension state of launcher with the GroupJob
@@ -685,14 +830,14 @@ func (c *GroupJobController) syncHandler(key string) error {
 
 	// cleanup the running worker pods if the MPI job is suspended
 	if isGroupJobSuspended(mpiJob) {
-		if err := cleanUpWorkerPods(mpiJob, c); err != nil {
+		if err := cleanUpWorkerPods(mpiJob, c, *mpiJob.Spec.RunPolicy.CleanPodPolicy); err != nil {
 			return err
 		}
 	}
 
 	// Finally, we update the status block of the GroupJob resource to reflect the
 	// current state of the world.
-	err = c.updateGroupJobStatus(mpiJob, launcher, worker)
+	err = c.updateGroupJobStatus(mpiJob, &sharedJob.Status, launcher, worker)
 	if err != nil {
 		return err
 	}
@@ -700,17 +845,27 @@ func (c *GroupJobController) syncHandler(key string) error {
 	return nil
 }
 
-func cleanUpWorkerPods(mpiJob *kubeflow.GroupJob, c *GroupJobController) error {
-	if err := c.deleteWorkerPods(mpiJob); err != nil {
+func cleanUpWorkerPods(mpiJob *kubeflow.GroupJob, c *GroupJobController, policy kubeflow.CleanPodPolicy) error {
+	if err := c.deleteWorkerPods(mpiJob, policy); err != nil {
 		return err
 	}
-	initializeGroupJobStatuses(mpiJob, kubeflow.MPIReplicaTypeWorker)
-	if c.PodGroupCtrl != nil {
-		if err := c.deletePodGroups(mpiJob); err != nil {
-			return err
-		}
+	for _, rType := range workerGroups(mpiJob) {
+		initializeGroupJobStatuses(mpiJob, rType)
 	}
-	mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Active = 0
+	return c.cleanUpPodGroups(mpiJob)
+}
+
+// cleanUpPodGroups deletes the PodGroups of mpiJob, if any, along with the
+// status they back.
+func (c *GroupJobController) cleanUpPodGroups(mpiJob *kubeflow.GroupJob) error {
+	if c.PodGroupCtrl == nil {
+		return nil
+	}
+	if err := c.deletePodGroups(mpiJob); err != nil {
+		return err
+	}
+	mpiJob.Status.PodGroupPhase = ""
+	mpiJob.Status.Conditions = filterOutCondition(mpiJob.Status.Conditions, kubeflow.JobGangScheduled)
 	return nil
 }
 
@@ -738,9 +893,180 @@ func (c *GroupJobController) getLauncherJob(mpiJob *kubeflow.GroupJob) (*batchv1
 	return launcher, nil
 }
 
+// suspendActiveLauncherJob suspends the launcher Job if it is still running
+// after the GroupJob finished, for example because a worker terminated with
+// a permanent exit code. This terminates its pods, but keeps the Job.
+func (c *GroupJobController) suspendActiveLauncherJob(mpiJob *kubeflow.GroupJob) error {
+	launcher, err := c.getLauncherJob(mpiJob)
+	if err != nil || launcher == nil || isJobFinished(launcher) || isJobSuspended(launcher) {
+		return err
+	}
+	launcher = launcher.DeepCopy()
+	launcher.Spec.Suspend = ptr.To(true)
+	_, err = c.kubeClient.BatchV1().Jobs(launcher.Namespace).Update(context.TODO(), launcher, metav1.UpdateOptions{})
+	return err
+}
+
+// deleteActiveLauncherJob deletes the launcher Job if it is still running, or
+// was suspended, after the GroupJob finished.
+func (c *GroupJobController) deleteActiveLauncherJob(mpiJob *kubeflow.GroupJob) error {
+	launcher, err := c.getLauncherJob(mpiJob)
+	if err != nil || launcher == nil || isJobFinished(launcher) {
+		return err
+	}
+	return c.deleteLauncherJob(launcher)
+}
+
+// deleteLauncherJob deletes the launcher Job along with its Pods.
+func (c *GroupJobController) deleteLauncherJob(launcher *batchv1.Job) error {
+	err := c.kubeClient.BatchV1().Jobs(launcher.Namespace).Delete(context.TODO(), launcher.Name, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// restartFailedGroup increments the restart count when a worker failed or was
+// evicted, or when the launcher Job failed, which makes the launcher Job and
+// all the worker Pods stale. Failures that are permanent under the ExitCode
+// restart policy, or that the failure policy fails the GroupJob for, are left
+// to updateGroupJobStatus. Once the backoff limit is
+// reached, the GroupJob is marked as failed instead.
+// It returns true while members of a previous attempt are being torn down.
+func (c *GroupJobController) restartFailedGroup(mpiJob *kubeflow.GroupJob, launcher *batchv1.Job) (bool, error) {
+	selector, err := workerSelector(mpiJob.Name)
+	if err != nil {
+		return false, err
+	}
+	workers, err := c.podLister.Pods(mpiJob.Namespace).List(selector)
+	if err != nil {
+		return false, err
+	}
+
+	// Members created before the last restart are stale. They are deleted, if
+	// they weren't already, and the group is not recreated until they are gone.
+	var stale []metav1.Object
+	if launcher != nil && isStaleGroupMember(mpiJob, launcher) {
+		stale = append(stale, launcher)
+	}
+	var (
+		cause string
+		// elasticCause is the first failure among the workers of an elastic
+		// GroupJob, which is tolerated while minReplicas workers did not fail.
+		elasticCause string
+		failed       int
+	)
+	for _, pod := range workers {
+		if !metav1.IsControlledBy(pod, mpiJob) {
+			continue
+		}
+		if isStaleGroupMember(mpiJob, pod) {
+			stale = append(stale, pod)
+			continue
+		}
+		if !isPodFailed(pod) {
+			continue
+		}
+		rType := workerGroupOf(mpiJob, pod)
+		spec := mpiJob.Spec.MPIReplicaSpecs[rType]
+		failureCause := podFailureCause(pod)
+		action := failureAction(mpiJob, failureCause)
+		if action == kubeflow.FailureActionFail {
+			return false, nil
+		}
+		if action == "" && spec != nil && spec.RestartPolicy == kubeflow.RestartPolicyExitCode {
+			if exitCode, _, ok := podExitCode(pod); ok && !isRetryableExitCode(spec, exitCode) {
+				return false, nil
+			}
+		}
+		podCause := fmt.Sprintf("worker pod %s %s", pod.Name, describeFailure(failureCause))
+		if rType == kubeflow.MPIReplicaTypeWorker && isElastic(mpiJob) {
+			failed++
+			if elasticCause == "" {
+				elasticCause = podCause
+			}
+		} else if cause == "" {
+			cause = podCause
+		}
+	}
+	if len(stale) > 0 {
+		for _, obj := range stale {
+			if obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if job, ok := obj.(*batchv1.Job); ok {
+				err = c.deleteLauncherJob(job)
+			} else {
+				err = c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Delete(context.TODO(), obj.GetName(), metav1.DeleteOptions{})
+			}
+			if err != nil && !apierrors.IsNotFound(err) {
+				return true, err
+			}
+		}
+		klog.V(4).Infof("Waiting for the previous attempt of GroupJob %s/%s to be torn down.", mpiJob.Namespace, mpiJob.Name)
+		return true, nil
+	}
+	// Failed workers of an elastic GroupJob are replaced individually, as long
+	// as enough of them did not fail.
+	if cause == "" && int(workerReplicas(mpiJob))-failed < int(minWorkerReplicas(mpiJob)) {
+		cause = elasticCause
+	}
+	if cause == "" && launcher != nil && isJobFailed(launcher) {
+		if getJobCondition(launcher, batchv1.JobFailed).Reason == batchv1.JobReasonPodFailurePolicy {
+			// The launcher terminated with a permanent exit code.
+			return false, nil
+		}
+		cause = fmt.Sprintf("launcher Job %s failed", launcher.Name)
+	}
+	if cause == "" {
+		return false, nil
+	}
+	nodes := recordNodeFailures(mpiJob, workers)
+
+	if limit := ptr.Deref(mpiJob.Spec.RunPolicy.BackoffLimit, defaultBackoffLimit); mpiJob.Status.RestartCount >= limit {
+		msg := fmt.Sprintf("GroupJob %s/%s has reached the backoff limit of %d restarts: %s", mpiJob.Namespace, mpiJob.Name, limit, cause)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobBackoffLimitExceededReason, msg)
+		if mpiJob.Status.CompletionTime == nil {
+			now := metav1.Now()
+			mpiJob.Status.CompletionTime = &now
+		}
+		updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobBackoffLimitExceededReason, msg)
+		mpiJobsFailureCount.Inc()
+		return true, c.updateStatusWithNodeFailures(mpiJob, nodes)
+	}
+
+	if held, changed := c.updateDebugHold(mpiJob, cause); changed {
+		return true, c.updateStatusWithNodeFailures(mpiJob, nodes)
+	} else if held {
+		return true, nil
+	}
+	// The restart count is persisted before the group is torn down, so that
+	// a failed update doesn't leave the group deleted without the restart
+	// counted. The members of the attempt become stale with it, and are
+	// deleted in the next sync.
+	mpiJob.Status.RestartCount++
+	msg := fmt.Sprintf("GroupJob %s/%s is restarting (restart %d): %s", mpiJob.Namespace, mpiJob.Name, mpiJob.Status.RestartCount, cause)
+	klog.Infof("GroupJob <%s/%s>: %v", mpiJob.Namespace, mpiJob.Name, msg)
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobRestartingReason, msg)
+	// The reason stays the same across restarts, so the condition is replaced
+	// to surface the latest message.
+	mpiJob.Status.Conditions = filterOutCondition(mpiJob.Status.Conditions, kubeflow.JobRestarting)
+	// The next failure gets a new debug hold.
+	mpiJob.Status.Conditions = filterOutCondition(mpiJob.Status.Conditions, kubeflow.JobDebugHold)
+	updateGroupJobConditions(mpiJob, kubeflow.JobRestarting, corev1.ConditionTrue, mpiJobRestartingReason, msg)
+	mpiJobsRestartCount.Inc()
+	return true, c.updateStatusWithNodeFailures(mpiJob, nodes)
+}
+
 // getOrCreatePodGroups will create a PodGroup for gang scheduling by volcano.
+// It returns nil for gang-schedulers without a PodGroup API.
 func (c *GroupJobController) getOrCreatePodGroups(mpiJob *kubeflow.GroupJob) (metav1.Object, error) {
 	newPodGroup := c.PodGroupCtrl.newPodGroup(mpiJob)
+	if newPodGroup == nil {
+		return nil, nil
+	}
 	podGroup, err := c.PodGroupCtrl.getPodGroup(newPodGroup.GetNamespace(), newPodGroup.GetName())
 	// If the PodGroup doesn't exist, we'll create it.
 	if apierrors.IsNotFound(err) {
@@ -766,6 +1092,50 @@ func (c *GroupJobController) getOrCreatePodGroups(mpiJob *kubeflow.GroupJob) (me
 	return podGroup, nil
 }
 
+// updatePodGroupStatus surfaces the phase of the PodGroup and the feedback of
+// the scheduler in the status of the GroupJob. Pods the scheduler marked as
+// unschedulable make the PodGroup unschedulable, which gives the feedback of
+// the scheduler when the gang-scheduler gives none. The PodGroup is nil for
+// gang-schedulers without a PodGroup API.
+func (c *GroupJobController) updatePodGroupStatus(mpiJob *kubeflow.GroupJob, podGroup metav1.Object, pods []*corev1.Pod) {
+	phase, msg := c.PodGroupCtrl.podGroupPhase(podGroup, pods)
+	if phase != kubeflow.PodGroupRunning {
+		if podMsg := unschedulablePodMessage(pods); podMsg != "" {
+			phase = kubeflow.PodGroupUnschedulable
+			if msg == "" {
+				msg = podMsg
+			}
+		}
+	}
+	mpiJob.Status.PodGroupPhase = phase
+	if phase == kubeflow.PodGroupRunning {
+		msg = fmt.Sprintf("The pods of GroupJob %s/%s are scheduled.", mpiJob.Namespace, mpiJob.Name)
+		updateGroupJobConditions(mpiJob, kubeflow.JobGangScheduled, corev1.ConditionTrue, string(phase), msg)
+		return
+	}
+	if msg == "" {
+		// The PodGroup has the name of the GroupJob.
+		msg = fmt.Sprintf("PodGroup %s/%s is %s.", mpiJob.Namespace, mpiJob.Name, phase)
+	}
+	msg = truncateMessage(msg)
+	if updateGroupJobConditions(mpiJob, kubeflow.JobGangScheduled, corev1.ConditionFalse, string(phase), msg) && phase == kubeflow.PodGroupUnschedulable {
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, string(phase), msg)
+	}
+}
+
+// unschedulablePodMessage returns the message of the first pod the scheduler
+// failed to find a node for, or an empty string if there is none.
+func unschedulablePodMessage(pods []*corev1.Pod) string {
+	for _, pod := range pods {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+				return fmt.Sprintf("pod %s: %s", pod.Name, cond.Message)
+			}
+		}
+	}
+	return ""
+}
+
 // deletePodGroups will delete a PodGroup when GroupJob have done.
 func (c *GroupJobController) deletePodGroups(mpiJob *kubeflow.GroupJob) error {
 	podGroup, err := c.PodGroupCtrl.getPodGroup(mpiJob.Namespace, mpiJob.Name)
@@ -838,7 +1208,17 @@ func (c *GroupJobController) getOrCreateConfigMap(mpiJob *kubeflow.GroupJob) (*c
 	if err != nil {
 		return nil, err
 	}
+	if isElastic(mpiJob) {
+		updateHostfileInConfigMap(newCM, mpiJob, podList)
+	}
 	updateDiscoverHostsInConfigMap(newCM, mpiJob, podList)
+	if len(topologyKey(mpiJob)) != 0 {
+		topology, err := c.workerTopology(mpiJob, podList)
+		if err != nil {
+			return nil, err
+		}
+		orderHostsByTopology(newCM, mpiJob, podList, topology)
+	}
 
 	cm, err := c.configMapLister.ConfigMaps(mpiJob.Namespace).Get(mpiJob.Name + configSuffix)
 	// If the ConfigMap doesn't exist, we'll create it.
@@ -897,6 +1277,9 @@ func (c *GroupJobController) getOrCreateService(job *kubeflow.GroupJob, newSvc *
 // getOrCreateSSHAuthSecret gets the Secret holding the SSH auth for this job,
 // or create one if it doesn't exist.
 func (c *GroupJobController) getOrCreateSSHAuthSecret(job *kubeflow.GroupJob) (*corev1.Secret, error) {
+	if job.Spec.SSHAuthSecretName != "" {
+		return c.getReferencedSSHAuthSecret(job)
+	}
 	secret, err := c.secretLister.Secrets(job.Namespace).Get(job.Name + sshAuthSecretSuffix)
 	if apierrors.IsNotFound(err) {
 		secret, err := newSSHAuthSecret(job)
@@ -913,17 +1296,46 @@ func (c *GroupJobController) getOrCreateSSHAuthSecret(job *kubeflow.GroupJob) (*
 		c.recorder.Event(job, corev1.EventTypeWarning, ErrResourceExists, msg)
 		return nil, errors.New(msg)
 	}
-	newSecret, err := newSSHAuthSecret(job)
-	if err != nil {
-		return nil, fmt.Errorf("generating new secret: %w", err)
-	}
-	hasKeys := keysFromData(secret.Data)
-	wantKeys := keysFromData(newSecret.Data)
-	if !equality.Semantic.DeepEqual(hasKeys, wantKeys) {
+	// The keys are generated again when entries are missing or when the
+	// algorithm changed, which is only allowed while the GroupJob isn't
+	// running.
+	if !equality.Semantic.DeepEqual(keysFromData(secret.Data), sshAuthSecretKeys) || !hasSSHKeyAlgorithm(secret.Data[sshPublicKey], job.Spec.SSHKeyAlgorithm) {
+		newSecret, err := newSSHAuthSecret(job)
+		if err != nil {
+			return nil, fmt.Errorf("generating new secret: %w", err)
+		}
 		secret := secret.DeepCopy()
 		secret.Data = newSecret.Data
 		return c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
 	}
+	// The hosts change when the GroupJob is scaled.
+	knownHosts := newKnownHosts(job, secret.Data[sshHostPublicKey])
+	if !bytes.Equal(secret.Data[sshKnownHosts], knownHosts) {
+		secret := secret.DeepCopy()
+		secret.Data[sshKnownHosts] = knownHosts
+		return c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	}
+	return secret, nil
+}
+
+// getReferencedSSHAuthSecret gets the Secret referenced by
+// spec.sshAuthSecretName and checks that it holds the SSH keys. The Secret is
+// not managed by the GroupJob.
+func (c *GroupJobController) getReferencedSSHAuthSecret(job *kubeflow.GroupJob) (*corev1.Secret, error) {
+	secret, err := c.secretLister.Secrets(job.Namespace).Get(job.Spec.SSHAuthSecretName)
+	if apierrors.IsNotFound(err) {
+		msg := fmt.Sprintf("SSH auth Secret %q referenced by the GroupJob not found", job.Spec.SSHAuthSecretName)
+		c.recorder.Event(job, corev1.EventTypeWarning, ValidationError, msg)
+		return nil, errors.New(msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if errs := validation.ValidateSSHAuthSecret(job, secret); len(errs) != 0 {
+		msg := truncateMessage(fmt.Sprintf("Found validation errors: %v", errs.ToAggregate()))
+		c.recorder.Event(job, corev1.EventTypeWarning, ValidationError, msg)
+		return nil, errors.New(msg)
+	}
 	return secret, nil
 }
 
@@ -936,16 +1348,16 @@ func keysFromData(data map[string][]byte) []string {
 	return keys
 }
 
-// getOrCreateWorkerStatefulSet gets the worker Pod controlled by this
-// GroupJob, or creates one if it doesn't exist.
+// getOrCreateWorker gets the worker Pods of all the worker groups controlled
+// by this GroupJob, or creates the ones that don't exist.
 func (c *GroupJobController) getOrCreateWorker(mpiJob *kubeflow.GroupJob) ([]*corev1.Pod, error) {
 	var workerPods []*corev1.Pod
-	worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
-	if worker == nil {
+	groups := workerGroups(mpiJob)
+	if len(groups) == 0 {
 		return workerPods, nil
 	}
 
-	// Remove Pods when replicas are scaled down
+	// Remove Pods when replicas are scaled down or worker groups are removed.
 	selector, err := workerSelector(mpiJob.Name)
 	if err != nil {
 		return nil, err
@@ -954,50 +1366,244 @@ func (c *GroupJobController) getOrCreateWorker(mpiJob *kubeflow.GroupJob) ([]*co
 	if err != nil {
 		return nil, err
 	}
-	if len(podFullList) > int(*worker.Replicas) {
-		for _, pod := range podFullList {
-			indexStr, ok := pod.Labels[kubeflow.ReplicaIndexLabel]
-			if !ok {
+	wantNames := sets.New[string]()
+	for _, rType := range groups {
+		for i := 0; i < int(*mpiJob.Spec.MPIReplicaSpecs[rType].Replicas); i++ {
+			wantNames.Insert(groupWorkerName(mpiJob, rType, i))
+		}
+	}
+	var defaultGroupPods []*corev1.Pod
+	for _, pod := range podFullList {
+		if !wantNames.Has(pod.Name) {
+			err = c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+			if err != nil {
+				return nil, err
+			}
+		} else if workerGroupOf(mpiJob, pod) == kubeflow.MPIReplicaTypeWorker {
+			defaultGroupPods = append(defaultGroupPods, pod)
+		}
+	}
+	// Elastic GroupJobs replace failed workers as long as the workers that did
+	// not fail are at least minReplicas.
+	replaceFailed := isElastic(mpiJob) && int(workerReplicas(mpiJob))-countFailedPods(defaultGroupPods) >= int(minWorkerReplicas(mpiJob))
+
+	// Failures are compared with the last restart persisted before this sync,
+	// so that each of the workers failing together is counted.
+	lastRestart := mpiJob.Status.LastRestartTime
+	restarted := false
+	for _, rType := range groups {
+		worker := mpiJob.Spec.MPIReplicaSpecs[rType]
+		specHash := c.replicaSpecHash(mpiJob, rType)
+		drifted := 0
+		var unreported []*corev1.Pod
+		for i := 0; i < int(*worker.Replicas); i++ {
+			pod, err := c.podLister.Pods(mpiJob.Namespace).Get(groupWorkerName(mpiJob, rType, i))
+
+			// If the worker Pod doesn't exist, we'll create it.
+			if apierrors.IsNotFound(err) {
+				worker := c.newGroupWorker(mpiJob, rType, i)
+				pod, err = c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Create(context.TODO(), worker, metav1.CreateOptions{})
+			}
+			// If an error occurs during Get/Create, we'll requeue the item so we
+			// can attempt processing again later. This could have been caused by a
+			// temporary network failure, or any other transient reason.
+			if err != nil {
+				c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, mpiJobFailedReason, "worker pod created failed: %v", err)
 				return nil, err
 			}
-			index, err := strconv.Atoi(indexStr)
-			if err == nil {
-				if index >= int(*worker.Replicas) {
+			// If the worker is not controlled by this GroupJob resource, we should log
+			// a warning to the event recorder and return.
+			if pod != nil && !metav1.IsControlledBy(pod, mpiJob) {
+				msg := fmt.Sprintf(MessageResourceExists, pod.Name, pod.Kind)
+				c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+				return nil, errors.New(msg)
+			}
+			// A failed worker is deleted, so that it is created again with the same
+			// index once the deletion is observed, when the failure policy restarts
+			// it, when it failed with a retryable exit code under the ExitCode
+			// restart policy, or when it can be replaced in an elastic GroupJob.
+			// It is kept while the GroupJob is held for debugging. Restarts, but
+			// not replacements, count towards the backoff limit, and the worker
+			// is only deleted once the restart count including it is persisted.
+			if isPodFailed(pod) && pod.DeletionTimestamp == nil {
+				failureCause := podFailureCause(pod)
+				action := failureAction(mpiJob, failureCause)
+				exitCode, container, hasExitCode := podExitCode(pod)
+				exitCodePolicy := worker.RestartPolicy == kubeflow.RestartPolicyExitCode && hasExitCode
+				var msg string
+				counted := false
+				switch {
+				case action == kubeflow.FailureActionFail:
+					// updateGroupJobStatus fails the GroupJob.
+				case action == kubeflow.FailureActionRestart:
+					msg = fmt.Sprintf("Restarting worker pod %s, which %s", pod.Name, describeFailure(failureCause))
+					counted = true
+				case exitCodePolicy && isRetryableExitCode(worker, exitCode):
+					msg = fmt.Sprintf("Restarting worker pod %s: container %q terminated with retryable exit code %d", pod.Name, container, exitCode)
+					counted = true
+				case replaceFailed && rType == kubeflow.MPIReplicaTypeWorker && !exitCodePolicy:
+					msg = fmt.Sprintf("Replacing failed worker pod %s of elastic GroupJob", pod.Name)
+				}
+				held := false
+				if msg != "" {
+					held, _ = c.updateDebugHold(mpiJob, msg)
+				}
+				if msg != "" && !held && counted && !isRestartCounted(lastRestart, pod) {
+					c.countWorkerRestart(mpiJob, msg)
+				} else if msg != "" && !held {
+					restarted = true
+					c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobRestartingReason, msg)
 					err = c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
-					if err != nil {
+					if err != nil && !apierrors.IsNotFound(err) {
 						return nil, err
 					}
 				}
 			}
+			if pod.DeletionTimestamp == nil && !isPodFailed(pod) && hasSpecDrift(pod, specHash) {
+				drifted++
+				if !isSpecChangeReported(pod, specHash) {
+					unreported = append(unreported, pod)
+				}
+			}
+			workerPods = append(workerPods, pod)
+		}
+		// Running workers are not recreated, as that would disrupt the MPI
+		// application. Suspending the GroupJob deletes them, so that they are
+		// created from the changed template on resume. The change is reported
+		// once, as it is recorded on the workers.
+		if len(unreported) > 0 {
+			msg := fmt.Sprintf("The %s template changed, but it is not applied to %d running pods; suspend the GroupJob to recreate them", rType, drifted)
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobSpecChangeIgnoredReason, msg)
+			for _, pod := range unreported {
+				_, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name, types.MergePatchType, specChangeReportedPatch(specHash), metav1.PatchOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return nil, err
+				}
+			}
 		}
 	}
+	if restarted {
+		// The next failure gets a new debug hold.
+		mpiJob.Status.Conditions = filterOutCondition(mpiJob.Status.Conditions, kubeflow.JobDebugHold)
+	}
 
-	for i := 0; i < int(*worker.Replicas); i++ {
-		pod, err := c.podLister.Pods(mpiJob.Namespace).Get(workerName(mpiJob, i))
+	return workerPods, nil
+}
 
-		// If the worker Pod doesn't exist, we'll create it.
-		if apierrors.IsNotFound(err) {
-			worker := c.newWorker(mpiJob, i)
-			pod, err = c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Create(context.TODO(), worker, metav1.CreateOptions{})
-		}
-		// If an error occurs during Get/Create, we'll requeue the item so we
-		// can attempt processing again later. This could have been caused by a
-		// temporary network failure, or any other transient reason.
-		if err != nil {
-			c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, mpiJobFailedReason, "worker pod created failed: %v", err)
-			return nil, err
-		}
-		// If the worker is not controlled by this GroupJob resource, we should log
-		// a warning to the event recorder and return.
-		if pod != nil && !metav1.IsControlledBy(pod, mpiJob) {
-			msg := fmt.Sprintf(MessageResourceExists, pod.Name, pod.Kind)
-			c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
-			return nil, errors.New(msg)
+// countWorkerRestart counts the restart of a failed worker, as described by
+// cause, or fails mpiJob once it reached the backoff limit.
+func (c *GroupJobController) countWorkerRestart(mpiJob *kubeflow.GroupJob, cause string) {
+	if isFinished(mpiJob.Status) {
+		return
+	}
+	if limit := ptr.Deref(mpiJob.Spec.RunPolicy.BackoffLimit, defaultBackoffLimit); mpiJob.Status.RestartCount >= limit {
+		msg := fmt.Sprintf("GroupJob %s/%s has reached the backoff limit of %d restarts: %s", mpiJob.Namespace, mpiJob.Name, limit, cause)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobBackoffLimitExceededReason, msg)
+		if mpiJob.Status.CompletionTime == nil {
+			now := metav1.Now()
+			mpiJob.Status.CompletionTime = &now
 		}
-		workerPods = append(workerPods, pod)
+		updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobBackoffLimitExceededReason, msg)
+		mpiJobsFailureCount.Inc()
+		return
 	}
+	mpiJob.Status.RestartCount++
+	mpiJob.Status.LastRestartTime = ptr.To(metav1.NewTime(c.clock.Now()))
+	mpiJobsRestartCount.Inc()
+}
 
-	return workerPods, nil
+// isRestartCounted returns whether the failure of the worker pod was counted
+// in a restart count persisted at last, that is if the pod failed, or was
+// created when its failure time is unknown, before then.
+func isRestartCounted(last *metav1.Time, pod *corev1.Pod) bool {
+	if last == nil {
+		return false
+	}
+	failureTime := podFailureTime(pod)
+	if failureTime.IsZero() {
+		failureTime = pod.CreationTimestamp
+	}
+	return !last.Before(&failureTime)
+}
+
+func isGroupRestartMode(mpiJob *kubeflow.GroupJob) bool {
+	return ptr.Deref(mpiJob.Spec.RunPolicy.RestartMode, kubeflow.RestartModePod) == kubeflow.RestartModeGroup
+}
+
+// isStaleGroupMember returns whether the object is being deleted or was
+// created before the last group restart.
+func isStaleGroupMember(mpiJob *kubeflow.GroupJob, obj metav1.Object) bool {
+	if obj.GetDeletionTimestamp() != nil {
+		return true
+	}
+	restartCount, err := strconv.ParseInt(obj.GetAnnotations()[kubeflow.RestartCountAnnotation], 10, 32)
+	if err != nil {
+		restartCount = 0
+	}
+	return int32(restartCount) < mpiJob.Status.RestartCount
+}
+
+// setRestartCountAnnotation records the current restart count of the GroupJob
+// on a launcher Job or worker Pod under the Group restart mode.
+func setRestartCountAnnotation(mpiJob *kubeflow.GroupJob, meta *metav1.ObjectMeta) {
+	if !isGroupRestartMode(mpiJob) {
+		return
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[kubeflow.RestartCountAnnotation] = strconv.Itoa(int(mpiJob.Status.RestartCount))
+}
+
+// replicaSpecHash returns the hash of the objects of a replica type generated
+// from the current GroupJob, which changes with any of the inputs of their
+// generation.
+func (c *GroupJobController) replicaSpecHash(mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType) string {
+	if rType == kubeflow.MPIReplicaTypeLauncher {
+		return c.newLauncherJob(mpiJob).Annotations[kubeflow.SpecHashAnnotation]
+	}
+	return c.newGroupWorker(mpiJob, rType, 0).Annotations[kubeflow.SpecHashAnnotation]
+}
+
+// podTemplateHash returns a hash of the generated parts of a launcher Job or
+// worker Pod.
+func podTemplateHash(objs ...any) string {
+	hasher := fnv.New32a()
+	encoder := json.NewEncoder(hasher)
+	for _, obj := range objs {
+		// Encoding to a hash never fails.
+		_ = encoder.Encode(obj)
+	}
+	return utilrand.SafeEncodeString(strconv.FormatUint(uint64(hasher.Sum32()), 10))
+}
+
+// isSpecChangeReported returns whether the change to the replica spec with
+// the given hash was already reported as not applied to the object.
+func isSpecChangeReported(obj metav1.Object, specHash string) bool {
+	return obj.GetAnnotations()[kubeflow.SpecChangeIgnoredAnnotation] == specHash
+}
+
+// specChangeReportedPatch records on a worker Pod that the change to the
+// replica spec with the given hash was reported.
+func specChangeReportedPatch(specHash string) []byte {
+	return []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, kubeflow.SpecChangeIgnoredAnnotation, specHash))
+}
+
+// setSpecHashAnnotation records the hash of the replica spec a launcher Job or
+// worker Pod is created from.
+func setSpecHashAnnotation(meta *metav1.ObjectMeta, specHash string) {
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[kubeflow.SpecHashAnnotation] = specHash
+}
+
+// hasSpecDrift returns whether the object was created from a different
+// version of its replica spec. Objects without the annotation were created by
+// an older version of the operator and are not considered drifted.
+func hasSpecDrift(obj metav1.Object, specHash string) bool {
+	hash, ok := obj.GetAnnotations()[kubeflow.SpecHashAnnotation]
+	return ok && hash != specHash
 }
 
 func isGroupJobSuspended(mpiJob *kubeflow.GroupJob) bool {
@@ -1008,18 +1614,15 @@ func isJobSuspended(job *batchv1.Job) bool {
 	return ptr.Deref(job.Spec.Suspend, false)
 }
 
-func (c *GroupJobController) deleteWorkerPods(mpiJob *kubeflow.GroupJob) error {
-	var (
-		workerPrefix       = mpiJob.Name + workerSuffix
-		i            int32 = 0
-	)
-	worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
-	if worker == nil {
-		return nil
+func (c *GroupJobController) deleteWorkerPods(mpiJob *kubeflow.GroupJob, policy kubeflow.CleanPodPolicy) error {
+	var names []string
+	for _, rType := range workerGroups(mpiJob) {
+		for i := 0; i < int(*mpiJob.Spec.MPIReplicaSpecs[rType].Replicas); i++ {
+			names = append(names, groupWorkerName(mpiJob, rType, i))
+		}
 	}
 
-	for ; i < *worker.Replicas; i++ {
-		name := fmt.Sprintf("%s-%d", workerPrefix, i)
+	for _, name := range names {
 		pod, err := c.podLister.Pods(mpiJob.Namespace).Get(name)
 
 		// If the worker Pod doesn't exist, no need to remove it.
@@ -1037,7 +1640,7 @@ func (c *GroupJobController) deleteWorkerPods(mpiJob *kubeflow.GroupJob) error {
 		// set to CleanPodPolicyRunning, keep the pod.
 		// Note that pending pod should still be removed under this
 		// situation, since it may turn to running in the future.
-		if *mpiJob.Spec.RunPolicy.CleanPodPolicy == kubeflow.CleanPodPolicyRunning && !isPodRunning(pod) && !isPodPending(pod) {
+		if policy == kubeflow.CleanPodPolicyRunning && !isPodRunning(pod) && !isPodPending(pod) {
 			// Keep the worker pod
 			continue
 		}
@@ -1050,11 +1653,17 @@ func (c *GroupJobController) deleteWorkerPods(mpiJob *kubeflow.GroupJob) error {
 	return nil
 }
 
-func (c *GroupJobController) updateGroupJobStatus(mpiJob *kubeflow.GroupJob, launcher *batchv1.Job, worker []*corev1.Pod) error {
-	oldStatus := mpiJob.Status.DeepCopy()
+// updateGroupJobStatus updates the status of mpiJob from its launcher and
+// workers. oldStatus is the status the sync started from, so that the changes
+// made earlier in the sync are written too.
+func (c *GroupJobController) updateGroupJobStatus(mpiJob *kubeflow.GroupJob, oldStatus *kubeflow.JobStatus, launcher *batchv1.Job, worker []*corev1.Pod) error {
+	mpiJob.Status.ObservedGeneration = mpiJob.Generation
+	nodes := recordNodeFailures(mpiJob, worker)
 	if isGroupJobSuspended(mpiJob) {
-		// it is suspended now
-		if updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended") {
+		// it is suspended now, keeping the reason the controller suspended it
+		// for, if any
+		if !hasCondition(mpiJob.Status, kubeflow.JobSuspended) &&
+			updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended") {
 			c.recorder.Event(mpiJob, corev1.EventTypeNormal, "GroupJobSuspended", "GroupJob suspended")
 		}
 	} else if getCondition(mpiJob.Status, kubeflow.JobSuspended) != nil {
@@ -1095,37 +1704,140 @@ func (c *GroupJobController) updateGroupJobStatus(mpiJob *kubeflow.GroupJob, lau
 	}
 
 	var (
-		running = 0
-		evict   = 0
+		running    = 0
+		evict      = 0
+		restarting = 0
+		// fatalEvict counts the evicted workers that are not tolerated, which
+		// are all of them except for the Worker group of elastic GroupJobs.
+		fatalEvict = 0
+		// elasticWorkers counts the workers of the Worker group.
+		elasticWorkers = 0
+		// permanentErrMsg describes the first worker that terminated with a
+		// permanent exit code under the ExitCode restart policy.
+		permanentErrMsg string
+		// policyErrMsg describes the first worker that failed with a cause
+		// the failure policy fails the GroupJob for, and policyErrReason is
+		// the reason of the cause.
+		policyErrMsg    string
+		policyErrReason string
+		// policyRestarting counts the restarting workers that the failure
+		// policy restarts.
+		policyRestarting = 0
 	)
 
-	initializeGroupJobStatuses(mpiJob, kubeflow.MPIReplicaTypeWorker)
-	//spec := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	elastic := isElastic(mpiJob)
+	for _, rType := range workerGroups(mpiJob) {
+		initializeGroupJobStatuses(mpiJob, rType)
+	}
 	for i := 0; i < len(worker); i++ {
+		rType := workerGroupOf(mpiJob, worker[i])
+		spec := mpiJob.Spec.MPIReplicaSpecs[rType]
+		if mpiJob.Status.ReplicaStatuses[rType] == nil {
+			initializeGroupJobStatuses(mpiJob, rType)
+		}
+		groupStatus := mpiJob.Status.ReplicaStatuses[rType]
+		if rType == kubeflow.MPIReplicaTypeWorker {
+			elasticWorkers++
+		}
 		switch worker[i].Status.Phase {
 		case corev1.PodFailed:
-			mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Failed += 1
-			if worker[i].Status.Reason == "Evicted" {
+			groupStatus.Failed += 1
+			cause := podFailureCause(worker[i])
+			if groupStatus.FailedByCause == nil {
+				groupStatus.FailedByCause = make(map[kubeflow.FailureCause]int32)
+			}
+			groupStatus.FailedByCause[cause] += 1
+			action := failureAction(mpiJob, cause)
+			if action == kubeflow.FailureActionFail {
+				if policyErrMsg == "" {
+					policyErrReason = failureReason(cause)
+					policyErrMsg = fmt.Sprintf("worker pod %s/%s %s", worker[i].Namespace, worker[i].Name, describeFailure(cause))
+				}
+			} else if action == kubeflow.FailureActionRestart {
+				restarting += 1
+				policyRestarting += 1
+			} else if cause == kubeflow.FailureCauseEvicted {
 				evict += 1
+				if !elastic || rType != kubeflow.MPIReplicaTypeWorker {
+					fatalEvict += 1
+				}
+			} else if spec != nil && spec.RestartPolicy == kubeflow.RestartPolicyExitCode {
+				exitCode, container, ok := podExitCode(worker[i])
+				if !ok {
+					break
+				}
+				if isRetryableExitCode(spec, exitCode) {
+					restarting += 1
+				} else if permanentErrMsg == "" {
+					permanentErrMsg = fmt.Sprintf("worker pod %s/%s: container %q terminated with permanent exit code %d",
+						worker[i].Namespace, worker[i].Name, container, exitCode)
+				}
 			}
 		case corev1.PodSucceeded:
-			mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Succeeded += 1
+			groupStatus.Succeeded += 1
 		case corev1.PodRunning:
 			running += 1
-			mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Active += 1
+			groupStatus.Active += 1
 		}
 	}
-	if evict > 0 {
+	// Elastic GroupJobs tolerate failed workers as long as the workers that
+	// did not fail are at least minReplicas.
+	belowMinReplicas := elastic && worker != nil &&
+		elasticWorkers-int(mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Failed) < int(minWorkerReplicas(mpiJob))
+	if fatalEvict > 0 || (evict > 0 && belowMinReplicas) {
 		msg := fmt.Sprintf("%d/%d workers are evicted", evict, len(worker))
 		klog.Infof("GroupJob <%s/%s>: %v", mpiJob.Namespace, mpiJob.Name, msg)
 		updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobEvict, msg)
 		c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobEvict, msg)
+	} else if belowMinReplicas && permanentErrMsg == "" && policyErrMsg == "" {
+		msg := fmt.Sprintf("%d/%d workers failed, fewer than minReplicas (%d) remain", mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Failed, elasticWorkers, minWorkerReplicas(mpiJob))
+		klog.Infof("GroupJob <%s/%s>: %v", mpiJob.Namespace, mpiJob.Name, msg)
+		if mpiJob.Status.CompletionTime == nil {
+			now := metav1.Now()
+			mpiJob.Status.CompletionTime = &now
+		}
+		if updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobBelowMinReplicasReason, msg) {
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobBelowMinReplicasReason, msg)
+			mpiJobsFailureCount.Inc()
+		}
+	}
+	if elastic && worker != nil {
+		mpiJob.Status.ElasticReplicas = ptr.To(mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Active)
+	}
+	if policyErrMsg != "" {
+		klog.Infof("GroupJob <%s/%s>: %v", mpiJob.Namespace, mpiJob.Name, policyErrMsg)
+		if mpiJob.Status.CompletionTime == nil {
+			now := metav1.Now()
+			mpiJob.Status.CompletionTime = &now
+		}
+		if updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, policyErrReason, policyErrMsg) {
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, policyErrReason, policyErrMsg)
+			mpiJobsFailureCount.Inc()
+		}
+	} else if permanentErrMsg != "" {
+		klog.Infof("GroupJob <%s/%s>: %v", mpiJob.Namespace, mpiJob.Name, permanentErrMsg)
+		if mpiJob.Status.CompletionTime == nil {
+			now := metav1.Now()
+			mpiJob.Status.CompletionTime = &now
+		}
+		if updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobPermanentExitCodeReason, permanentErrMsg) {
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobPermanentExitCodeReason, permanentErrMsg)
+			mpiJobsFailureCount.Inc()
+		}
+	} else if restarting > 0 && !isFinished(mpiJob.Status) {
+		msg := fmt.Sprintf("%d/%d workers are restarting after a retryable exit code", restarting, len(worker))
+		if policyRestarting > 0 {
+			msg = fmt.Sprintf("%d/%d workers are restarting under the failure policy", restarting, len(worker))
+		}
+		if updateGroupJobConditions(mpiJob, kubeflow.JobRestarting, corev1.ConditionTrue, mpiJobRestartingReason, msg) {
+			c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobRestartingReason, msg)
+		}
 	}
 
 	if isGroupJobSuspended(mpiJob) {
 		msg := fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
 		updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
-	} else if launcher != nil && launcherPodsCnt >= 1 && running == len(worker) {
+	} else if launcher != nil && launcherPodsCnt >= 1 && running >= workersNeeded(mpiJob, worker) {
 		msg := fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
 		updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
 		c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, "GroupJobRunning", "GroupJob %s/%s is running", mpiJob.Namespace, mpiJob.Name)
@@ -1133,7 +1845,7 @@ func (c *GroupJobController) updateGroupJobStatus(mpiJob *kubeflow.GroupJob, lau
 
 	// no need to update the mpijob if the status hasn't changed since last time.
 	if !reflect.DeepEqual(*oldStatus, mpiJob.Status) {
-		return c.updateStatusHandler(mpiJob)
+		return c.updateStatusWithNodeFailures(mpiJob, nodes)
 	}
 	return nil
 }
@@ -1193,6 +1905,17 @@ func (c *GroupJobController) enqueueGroupJob(obj interface{}) {
 	c.queue.AddRateLimited(key)
 }
 
+// enqueueAfter puts mpiJob back onto the work queue once d passed, for the
+// syncs that wait for a deadline.
+func (c *GroupJobController) enqueueAfter(mpiJob *kubeflow.GroupJob, d time.Duration) {
+	key, err := cache.MetaNamespaceKeyFunc(mpiJob)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	c.queue.AddAfter(key, d)
+}
+
 // handleObject will take any resource implementing metav1.Object and attempt
 // to find the GroupJob resource that 'owns' it. It does this by looking at the
 // objects metadata.ownerReferences field for an appropriate OwnerReference.
@@ -1240,8 +1963,10 @@ func (c *GroupJobController) handleObject(obj interface{}) {
 	}
 
 	// Compare the OwnerReference Group and Kind against the OwnerType Group and Kind.
-	// Since we do not support conversion webhook now, we do not deal with v1alpha1/v1alpha2/v1 resources in this operator.
-	if ownerGVK.Kind != kubeflow.Kind || ownerGVK.Group != kubeflow.GroupName || ownerGVK.Version != kubeflow.GroupVersion {
+	// Owner references may carry either served version; the conversion webhook
+	// takes care of translating between them.
+	if ownerGVK.Kind != kubeflow.Kind || ownerGVK.Group != kubeflow.GroupName ||
+		(ownerGVK.Version != kubeflow.GroupVersion && ownerGVK.Version != kubeflowv1.GroupVersion) {
 		return
 	}
 
@@ -1275,33 +2000,10 @@ func (c *GroupJobController) doUpdateJobStatus(mpiJob *kubeflow.GroupJob) error
 // newConfigMap creates a new ConfigMap containing configurations for an GroupJob
 // resource. It also sets the appropriate OwnerReferences on the resource so
 // handleObject can discover the GroupJob resource that 'owns' it.
+// The hostfile lists workerReplicas workers of the Worker group, followed by
+// the workers of the other worker groups.
 func newConfigMap(mpiJob *kubeflow.GroupJob, workerReplicas int32) *corev1.ConfigMap {
-	var buffer bytes.Buffer
-	slots := ptr.Deref(mpiJob.Spec.SlotsPerWorker, 1)
-	// note that pod.spec.dnsConfig also affect the svc resolution
-	// ref: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/
-	// launcher can be reach with hostname or service name
-	if runLauncherAsWorker(mpiJob) {
-		name := mpiJob.Name + launcherSuffix
-		switch mpiJob.Spec.MPIImplementation {
-		case kubeflow.MPIImplementationOpenMPI:
-			buffer.WriteString(fmt.Sprintf("%s.%s.%s.svc slots=%d\n", name, mpiJob.Name, mpiJob.Namespace, slots))
-		case kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH:
-			buffer.WriteString(fmt.Sprintf("%s.%s.%s.svc:%d\n", name, mpiJob.Name, mpiJob.Namespace, slots))
-		}
-	}
-
-	for i := 0; i < int(workerReplicas); i++ {
-		name := workerName(mpiJob, i)
-		switch mpiJob.Spec.MPIImplementation {
-		case kubeflow.MPIImplementationOpenMPI:
-			buffer.WriteString(fmt.Sprintf("%s.%s.%s.svc slots=%d\n", name, mpiJob.Name, mpiJob.Namespace, slots))
-		case kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH:
-			buffer.WriteString(fmt.Sprintf("%s.%s.%s.svc:%d\n", name, mpiJob.Name, mpiJob.Namespace, slots))
-		}
-	}
-
-	return &corev1.ConfigMap{
+	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      mpiJob.Name + configSuffix,
 			Namespace: mpiJob.Namespace,
@@ -1313,9 +2015,88 @@ func newConfigMap(mpiJob *kubeflow.GroupJob, workerReplicas int32) *corev1.Confi
 			},
 		},
 		Data: map[string]string{
-			hostfileName: buffer.String(),
+			hostfileName: newHostfile(mpiJob, hostfileWorkers(mpiJob, workerReplicas)),
 		},
 	}
+	if isExecBootstrap(mpiJob) {
+		cm.Data[execAgentScriptName] = execAgentScript
+	}
+	if isSSHNonRoot(mpiJob) {
+		cm.Data[sshdConfigName] = newSSHDConfig(mpiJob)
+	}
+	return cm
+}
+
+// hostfileEntry is a host listed in the hostfile along with its slots.
+type hostfileEntry struct {
+	name  string
+	slots int32
+}
+
+// hostfileWorkers returns the workers listed in the hostfile when the default
+// worker group has workerReplicas replicas.
+func hostfileWorkers(mpiJob *kubeflow.GroupJob, workerReplicas int32) []hostfileEntry {
+	groups := workerGroups(mpiJob)
+	if len(groups) == 0 || groups[0] != kubeflow.MPIReplicaTypeWorker {
+		groups = append([]kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeWorker}, groups...)
+	}
+	var workers []hostfileEntry
+	for _, rType := range groups {
+		replicas := workerReplicas
+		if rType != kubeflow.MPIReplicaTypeWorker {
+			replicas = ptr.Deref(mpiJob.Spec.MPIReplicaSpecs[rType].Replicas, 0)
+		}
+		slots := groupSlotsPerWorker(mpiJob, rType)
+		for i := 0; i < int(replicas); i++ {
+			workers = append(workers, hostfileEntry{name: groupWorkerName(mpiJob, rType, i), slots: slots})
+		}
+	}
+	return workers
+}
+
+// hostFQDN returns the name the Pod with the given hostname is reached at
+// through the Service of the GroupJob.
+func hostFQDN(mpiJob *kubeflow.GroupJob, hostname string) string {
+	return fmt.Sprintf("%s.%s.%s.svc", hostname, mpiJob.Name, mpiJob.Namespace)
+}
+
+// newHostfile returns the content of the hostfile listing the given workers.
+func newHostfile(mpiJob *kubeflow.GroupJob, workers []hostfileEntry) string {
+	var buffer bytes.Buffer
+	// note that pod.spec.dnsConfig also affect the svc resolution
+	// ref: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/
+	// launcher can be reach with hostname or service name
+	if runLauncherAsWorker(mpiJob) {
+		launcherEntry := hostfileEntry{name: mpiJob.Name + launcherSuffix, slots: ptr.Deref(mpiJob.Spec.SlotsPerWorker, 1)}
+		workers = append([]hostfileEntry{launcherEntry}, workers...)
+	}
+
+	driver, ok := mpiDrivers[mpiJob.Spec.MPIImplementation]
+	if !ok {
+		return ""
+	}
+	for _, w := range workers {
+		buffer.WriteString(driver.HostfileLine(hostFQDN(mpiJob, w.name), w.slots))
+	}
+	return buffer.String()
+}
+
+// updateHostfileInConfigMap lists only the running workers in the hostfile of
+// an elastic GroupJob, matching the content of `discover_hosts.sh`.
+func updateHostfileInConfigMap(configMap *corev1.ConfigMap, mpiJob *kubeflow.GroupJob, runningPods []*corev1.Pod) {
+	configMap.Data[hostfileName] = newHostfile(mpiJob, runningHostfileWorkers(mpiJob, runningPods))
+}
+
+// runningHostfileWorkers returns the running workers sorted by name.
+func runningHostfileWorkers(mpiJob *kubeflow.GroupJob, runningPods []*corev1.Pod) []hostfileEntry {
+	workers := make([]hostfileEntry, 0, len(runningPods))
+	for _, p := range runningPods {
+		workers = append(workers, hostfileEntry{name: p.Name, slots: groupSlotsPerWorker(mpiJob, workerGroupOf(mpiJob, p))})
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].name < workers[j].name
+	})
+	return workers
 }
 
 // updateDiscoverHostsInConfigMap updates the ConfigMap if the content of `discover_hosts.sh` changes.
@@ -1324,7 +2105,12 @@ func updateDiscoverHostsInConfigMap(configMap *corev1.ConfigMap, mpiJob *kubeflo
 	sort.Slice(runningPods, func(i, j int) bool {
 		return runningPods[i].Name < runningPods[j].Name
 	})
+	configMap.Data[discoverHostsScriptName] = newDiscoverHostsScript(mpiJob, runningPods)
+}
 
+// newDiscoverHostsScript returns the content of `discover_hosts.sh` listing
+// the given running workers.
+func newDiscoverHostsScript(mpiJob *kubeflow.GroupJob, runningPods []*corev1.Pod) string {
 	var buffer bytes.Buffer
 	buffer.WriteString("#!/bin/sh\n")
 
@@ -1337,8 +2123,7 @@ func updateDiscoverHostsInConfigMap(configMap *corev1.ConfigMap, mpiJob *kubeflo
 	for _, p := range runningPods {
 		buffer.WriteString(fmt.Sprintf("echo %s.%s.%s.svc\n", p.Name, mpiJob.Name, p.Namespace))
 	}
-
-	configMap.Data[discoverHostsScriptName] = buffer.String()
+	return buffer.String()
 }
 
 // newJobService creates a Service with the same name of Job for both launcher and worker pods
@@ -1370,24 +2155,16 @@ func newService(job *kubeflow.GroupJob, name string, selector map[string]string)
 }
 
 // newSSHAuthSecret creates a new Secret that holds SSH auth: a private Key
-// and its public key version.
+// and its public key version, the host key of the workers and the known_hosts
+// file listing them.
 func newSSHAuthSecret(job *kubeflow.GroupJob) (*corev1.Secret, error) {
-	privateKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	privatePEM, publicKey, err := newSSHKey(job.Spec.SSHKeyAlgorithm)
 	if err != nil {
-		return nil, fmt.Errorf("generating private SSH key: %w", err)
+		return nil, err
 	}
-	privateDER, err := x509.MarshalECPrivateKey(privateKey)
+	hostPrivatePEM, hostPublicKey, err := newSSHKey(job.Spec.SSHKeyAlgorithm)
 	if err != nil {
-		return nil, fmt.Errorf("converting private SSH key to DER format: %w", err)
-	}
-	privatePEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "EC PRIVATE KEY",
-		Bytes: privateDER,
-	})
-
-	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
-	if err != nil {
-		return nil, fmt.Errorf("generating public SSH key: %w", err)
+		return nil, fmt.Errorf("generating SSH host key: %w", err)
 	}
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1403,13 +2180,173 @@ func newSSHAuthSecret(job *kubeflow.GroupJob) (*corev1.Secret, error) {
 		Type: corev1.SecretTypeSSHAuth,
 		Data: map[string][]byte{
 			corev1.SSHAuthPrivateKey: privatePEM,
-			sshPublicKey:             ssh.MarshalAuthorizedKey(publicKey),
+			sshPublicKey:             publicKey,
+			sshHostPrivateKey:        hostPrivatePEM,
+			sshHostPublicKey:         hostPublicKey,
+			sshKnownHosts:            newKnownHosts(job, hostPublicKey),
 		},
 	}, nil
 }
 
+// newSSHKey generates an SSH keypair with the given algorithm, ECDSA if empty.
+// It returns the private key in PEM format and the public key in the
+// authorized_keys format.
+func newSSHKey(algorithm kubeflow.SSHKeyAlgorithm) ([]byte, []byte, error) {
+	var privateBlock *pem.Block
+	var cryptoPublicKey crypto.PublicKey
+	switch algorithm {
+	case kubeflow.SSHKeyAlgorithmEd25519:
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generating private SSH key: %w", err)
+		}
+		// OpenSSH only reads Ed25519 keys in its own format.
+		privateBlock, err = ssh.MarshalPrivateKey(privateKey, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting private SSH key to OpenSSH format: %w", err)
+		}
+		cryptoPublicKey = publicKey
+	case kubeflow.SSHKeyAlgorithmRSA:
+		privateKey, err := rsa.GenerateKey(rand.Reader, 4096)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generating private SSH key: %w", err)
+		}
+		privateBlock = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+		}
+		cryptoPublicKey = &privateKey.PublicKey
+	default:
+		privateKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generating private SSH key: %w", err)
+		}
+		privateDER, err := x509.MarshalECPrivateKey(privateKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting private SSH key to DER format: %w", err)
+		}
+		privateBlock = &pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: privateDER,
+		}
+		cryptoPublicKey = &privateKey.PublicKey
+	}
+
+	publicKey, err := ssh.NewPublicKey(cryptoPublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating public SSH key: %w", err)
+	}
+	return pem.EncodeToMemory(privateBlock), ssh.MarshalAuthorizedKey(publicKey), nil
+}
+
+// hasSSHKeyAlgorithm returns whether the public key in the authorized_keys
+// format was generated with the given algorithm, ECDSA if empty.
+func hasSSHKeyAlgorithm(authorizedKey []byte, algorithm kubeflow.SSHKeyAlgorithm) bool {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey(authorizedKey)
+	if err != nil {
+		return false
+	}
+	switch algorithm {
+	case kubeflow.SSHKeyAlgorithmEd25519:
+		return publicKey.Type() == ssh.KeyAlgoED25519
+	case kubeflow.SSHKeyAlgorithmRSA:
+		return publicKey.Type() == ssh.KeyAlgoRSA
+	default:
+		return publicKey.Type() == ssh.KeyAlgoECDSA521
+	}
+}
+
+// newKnownHosts returns the known_hosts file accepting hostPublicKey for every
+// host of the hostfile. All of them share the host key of the GroupJob. The
+// hosts are also listed with any port, as sshd often listens on a non-default
+// port when running as non-root.
+func newKnownHosts(job *kubeflow.GroupJob, hostPublicKey []byte) []byte {
+	var names []string
+	if runLauncherAsWorker(job) {
+		names = append(names, job.Name+launcherSuffix)
+	}
+	for _, w := range hostfileWorkers(job, workerReplicas(job)) {
+		names = append(names, w.name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	hosts := make([]string, 0, 2*len(names))
+	for _, name := range names {
+		host := hostFQDN(job, name)
+		hosts = append(hosts, host, fmt.Sprintf("[%s]:*", host))
+	}
+	return []byte(strings.Join(hosts, ",") + " " + string(hostPublicKey))
+}
+
 func workerName(mpiJob *kubeflow.GroupJob, index int) string {
-	return fmt.Sprintf("%s%s-%d", mpiJob.Name, workerSuffix, index)
+	return groupWorkerName(mpiJob, kubeflow.MPIReplicaTypeWorker, index)
+}
+
+// groupWorkerName returns the name of the worker Pod with the given index in
+// a worker group. The workers of the Worker group are named
+// `<job>-worker-<index>`.
+func groupWorkerName(mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType, index int) string {
+	return fmt.Sprintf("%s-%s-%d", mpiJob.Name, workerGroupName(rType), index)
+}
+
+// workerGroupName returns the lowercase name of a worker group used in the
+// names and labels of its Pods.
+func workerGroupName(rType kubeflow.MPIReplicaType) string {
+	return strings.ToLower(string(rType))
+}
+
+// workerGroups returns the worker groups of the GroupJob: the Worker group
+// first, if present, followed by the other groups sorted by name.
+func workerGroups(mpiJob *kubeflow.GroupJob) []kubeflow.MPIReplicaType {
+	var groups []kubeflow.MPIReplicaType
+	for rType := range mpiJob.Spec.MPIReplicaSpecs {
+		if rType != kubeflow.MPIReplicaTypeLauncher && rType != kubeflow.MPIReplicaTypeWorker {
+			groups = append(groups, rType)
+		}
+	}
+	slices.Sort(groups)
+	if _, ok := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; ok {
+		groups = append([]kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeWorker}, groups...)
+	}
+	return groups
+}
+
+// workerGroupOf returns the worker group of a worker Pod. Pods without the
+// replica type label belong to the Worker group.
+func workerGroupOf(mpiJob *kubeflow.GroupJob, pod *corev1.Pod) kubeflow.MPIReplicaType {
+	name, ok := pod.Labels[kubeflow.ReplicaTypeLabel]
+	if !ok {
+		return kubeflow.MPIReplicaTypeWorker
+	}
+	for _, rType := range workerGroups(mpiJob) {
+		if workerGroupName(rType) == name {
+			return rType
+		}
+	}
+	return kubeflow.MPIReplicaTypeWorker
+}
+
+// groupSlotsPerWorker returns the slots of the workers of a worker group,
+// which default to .spec.slotsPerWorker.
+func groupSlotsPerWorker(mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType) int32 {
+	if spec := mpiJob.Spec.MPIReplicaSpecs[rType]; spec != nil && spec.SlotsPerWorker != nil {
+		return *spec.SlotsPerWorker
+	}
+	return ptr.Deref(mpiJob.Spec.SlotsPerWorker, 1)
+}
+
+// workerGroupIndexOffset returns the replica index of the first worker of a
+// worker group, so that indexes are unique across all the worker groups.
+func workerGroupIndexOffset(mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType) int {
+	offset := 0
+	for _, group := range workerGroups(mpiJob) {
+		if group == rType {
+			break
+		}
+		offset += int(ptr.Deref(mpiJob.Spec.MPIReplicaSpecs[group].Replicas, 0))
+	}
+	return offset
 }
 
 func runLauncherAsWorker(mpiJob *kubeflow.GroupJob) bool {
@@ -1425,13 +2362,18 @@ func workerReplicaIndexLabel(mpiJob *kubeflow.GroupJob, index int) string {
 	return strconv.Itoa(index)
 }
 
-// newWorker creates a new worker Pod for an GroupJob resource. It also
-// sets the appropriate OwnerReferences on the resource so handleObject can
-// discover the GroupJob resource that 'owns' it.
+// newWorker creates a new worker Pod of the Worker group.
 func (c *GroupJobController) newWorker(mpiJob *kubeflow.GroupJob, index int) *corev1.Pod {
-	name := workerName(mpiJob, index)
+	return c.newGroupWorker(mpiJob, kubeflow.MPIReplicaTypeWorker, index)
+}
 
-	podTemplate := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.DeepCopy()
+// newGroupWorker creates a new worker Pod of a worker group for an GroupJob
+// resource. It also sets the appropriate OwnerReferences on the resource so
+// handleObject can discover the GroupJob resource that 'owns' it.
+func (c *GroupJobController) newGroupWorker(mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType, index int) *corev1.Pod {
+	name := groupWorkerName(mpiJob, rType, index)
+
+	podTemplate := mpiJob.Spec.MPIReplicaSpecs[rType].Template.DeepCopy()
 
 	// keep the labels which are set in PodTemplate
 	if len(podTemplate.Labels) == 0 {
@@ -1440,36 +2382,58 @@ func (c *GroupJobController) newWorker(mpiJob *kubeflow.GroupJob, index int) *co
 	for key, value := range defaultLabels(mpiJob.Name, worker) {
 		podTemplate.Labels[key] = value
 	}
-	podTemplate.Labels[kubeflow.ReplicaIndexLabel] = workerReplicaIndexLabel(mpiJob, index)
-	podTemplate.Spec.Hostname = name
+	podTemplate.Labels[kubeflow.ReplicaTypeLabel] = workerGroupName(rType)
 	podTemplate.Spec.Subdomain = mpiJob.Name // Matches job' Service name.
 	if podTemplate.Spec.HostNetwork {
 		// Allows resolution of worker hostnames without needing to include the
 		// namespace or cluster domain.
 		podTemplate.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
 	}
-	// The Intel and MPICH implementations require workers to communicate with the launcher through its hostname.
+	// The Hydra based implementations require workers to communicate with the launcher through its hostname.
 	searche := fmt.Sprintf("%s.%s.svc.cluster.local", mpiJob.Name, mpiJob.Namespace)
 	if podTemplate.Spec.DNSConfig == nil {
 		podTemplate.Spec.DNSConfig = &corev1.PodDNSConfig{Searches: []string{searche}}
 	} else {
 		podTemplate.Spec.DNSConfig.Searches = append(podTemplate.Spec.DNSConfig.Searches, searche)
 	}
-	setRestartPolicy(podTemplate, mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker])
+	setRestartPolicy(podTemplate, mpiJob.Spec.MPIReplicaSpecs[rType])
 
 	container := &podTemplate.Spec.Containers[0]
-	if len(container.Command) == 0 && len(container.Args) == 0 {
-		container.Command = []string{"/usr/sbin/sshd", "-De"}
+	if isExecBootstrap(mpiJob) {
+		if len(container.Command) == 0 && len(container.Args) == 0 {
+			container.Command = execWorkerCommand
+		}
+		container.Env = append(container.Env, workerEnvVars...)
+	} else {
+		if len(container.Command) == 0 && len(container.Args) == 0 {
+			container.Command = sshdCommand(mpiJob)
+		}
+		container.Env = append(container.Env, workerEnvVars...)
+		c.setupSSHOnPod(&podTemplate.Spec, mpiJob)
+		if isSSHNonRoot(mpiJob) {
+			setupSSHDConfigOnPod(&podTemplate.Spec, mpiJob)
+			setRestrictedSecurityContext(&podTemplate.Spec)
+		} else {
+			restrictSSHPrivateKeys(&podTemplate.Spec)
+		}
 	}
-	container.Env = append(container.Env, workerEnvVars...)
-	c.setupSSHOnPod(&podTemplate.Spec, mpiJob)
+	setTopologyAffinity(&podTemplate.Spec, mpiJob)
 
 	// add SchedulerName to podSpec
 	if c.PodGroupCtrl != nil {
-		c.PodGroupCtrl.decoratePodTemplateSpec(podTemplate, mpiJob.Name)
+		c.PodGroupCtrl.decoratePodTemplateSpec(podTemplate, mpiJob, rType)
 	}
+	// The template is hashed before the fields that differ between the
+	// workers, and the nodes to avoid, which change with the status.
+	specHash := podTemplateHash(podTemplate)
+
+	// The replica index label is unique across the worker groups, while the
+	// names keep the index within the group.
+	podTemplate.Labels[kubeflow.ReplicaIndexLabel] = workerReplicaIndexLabel(mpiJob, workerGroupIndexOffset(mpiJob, rType)+index)
+	podTemplate.Spec.Hostname = name
+	avoidFailedNodes(&podTemplate.Spec, mpiJob)
 
-	return &corev1.Pod{
+	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
 			Namespace:   mpiJob.Namespace,
@@ -1481,6 +2445,9 @@ func (c *GroupJobController) newWorker(mpiJob *kubeflow.GroupJob, index int) *co
 		},
 		Spec: podTemplate.Spec,
 	}
+	setRestartCountAnnotation(mpiJob, &pod.ObjectMeta)
+	setSpecHashAnnotation(&pod.ObjectMeta, specHash)
+	return pod
 }
 
 func (c *GroupJobController) newLauncherJob(mpiJob *kubeflow.GroupJob) *batchv1.Job {
@@ -1502,12 +2469,34 @@ func (c *GroupJobController) newLauncherJob(mpiJob *kubeflow.GroupJob) *batchv1.
 			Template:                c.newLauncherPodTemplate(mpiJob),
 		},
 	}
+	setRestartCountAnnotation(mpiJob, &job.ObjectMeta)
+	if launcherSpec := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]; launcherSpec.RestartPolicy == kubeflow.RestartPolicyExitCode {
+		job.Spec.PodFailurePolicy = newExitCodePodFailurePolicy(launcherSpec)
+	}
+	setSpecHashAnnotation(&job.ObjectMeta, podTemplateHash(&job.Spec.Template, job.Spec.PodFailurePolicy))
 	if isGroupJobSuspended(mpiJob) {
 		job.Spec.Suspend = ptr.To(true)
 	}
 	return job
 }
 
+// newExitCodePodFailurePolicy returns a podFailurePolicy that fails the
+// launcher Job as soon as a container terminates with a permanent exit code.
+// Pods failing with a retryable exit code count towards the backoffLimit.
+func newExitCodePodFailurePolicy(spec *kubeflow.ReplicaSpec) *batchv1.PodFailurePolicy {
+	return &batchv1.PodFailurePolicy{
+		Rules: []batchv1.PodFailurePolicyRule{
+			{
+				Action: batchv1.PodFailurePolicyActionFailJob,
+				OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					Operator: batchv1.PodFailurePolicyOnExitCodesOpNotIn,
+					Values:   append([]int32{0}, retryableExitCodes(spec)...),
+				},
+			},
+		},
+	}
+}
+
 // newLauncherPodTemplate creates a new launcher Job for an GroupJob resource. It also sets
 // the appropriate OwnerReferences on the resource so handleObject can discover
 // the GroupJob resource that 'owns' it.
@@ -1524,10 +2513,11 @@ func (c *GroupJobController) newLauncherPodTemplate(mpiJob *kubeflow.GroupJob) c
 	}
 	// add SchedulerName to podSpec
 	if c.PodGroupCtrl != nil {
-		c.PodGroupCtrl.decoratePodTemplateSpec(podTemplate, mpiJob.Name)
+		c.PodGroupCtrl.decoratePodTemplateSpec(podTemplate, mpiJob, kubeflow.MPIReplicaTypeLauncher)
 	}
 	if runLauncherAsWorker(mpiJob) {
 		podTemplate.Labels[kubeflow.ReplicaIndexLabel] = "0"
+		setTopologyAffinity(&podTemplate.Spec, mpiJob)
 	}
 	podTemplate.Spec.Hostname = launcherName
 	podTemplate.Spec.Subdomain = mpiJob.Name // Matches job' Service name.
@@ -1538,23 +2528,7 @@ func (c *GroupJobController) newLauncherPodTemplate(mpiJob *kubeflow.GroupJob) c
 	}
 	container := &podTemplate.Spec.Containers[0]
 	container.Env = append(container.Env, launcherEnvVars...)
-	slotsStr := strconv.Itoa(int(*mpiJob.Spec.SlotsPerWorker))
-	switch mpiJob.Spec.MPIImplementation {
-	case kubeflow.MPIImplementationOpenMPI:
-		container.Env = append(container.Env, ompiEnvVars...)
-		container.Env = append(container.Env, corev1.EnvVar{
-			Name:  openMPISlotsEnv,
-			Value: slotsStr,
-		})
-	case kubeflow.MPIImplementationIntel:
-		container.Env = append(container.Env, intelEnvVars...)
-		container.Env = append(container.Env, corev1.EnvVar{
-			Name:  intelMPISlotsEnv,
-			Value: slotsStr,
-		})
-	case kubeflow.MPIImplementationMPICH:
-		container.Env = append(container.Env, mpichEnvVars...)
-	}
+	container.Env = append(container.Env, launcherMPIEnvVars(mpiJob)...)
 	if !runLauncherAsWorker(mpiJob) {
 		container.Env = append(container.Env,
 			// We overwrite these environment variables so that users will not
@@ -1562,15 +2536,19 @@ func (c *GroupJobController) newLauncherPodTemplate(mpiJob *kubeflow.GroupJob) c
 			// issues with scheduler/container technologies.
 			nvidiaDisableEnvVars...)
 	}
-	c.setupSSHOnPod(&podTemplate.Spec, mpiJob)
-
-	// Submit a warning event if the user specifies restart policy for
-	// the pod template. We recommend to set it from the replica level.
-	if podTemplate.Spec.RestartPolicy != "" {
-		errMsg := "Restart policy in pod template overridden by restart policy in replica spec"
-		klog.Warning(errMsg)
-		c.recorder.Event(mpiJob, corev1.EventTypeWarning, podTemplateRestartPolicyReason, errMsg)
+	volumeItems := configVolumeItems
+	if isExecBootstrap(mpiJob) {
+		volumeItems = append(slices.Clip(volumeItems), execAgentVolumeItem)
+		if podTemplate.Spec.ServiceAccountName == "" {
+			podTemplate.Spec.ServiceAccountName = mpiJob.Name + launcherSuffix
+		}
+	} else {
+		c.setupSSHOnPod(&podTemplate.Spec, mpiJob)
+		if isSSHNonRoot(mpiJob) {
+			setRestrictedSecurityContext(&podTemplate.Spec)
+		}
 	}
+
 	setRestartPolicy(podTemplate, mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher])
 
 	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes,
@@ -1581,7 +2559,7 @@ func (c *GroupJobController) newLauncherPodTemplate(mpiJob *kubeflow.GroupJob) c
 					LocalObjectReference: corev1.LocalObjectReference{
 						Name: mpiJob.Name + configSuffix,
 					},
-					Items: configVolumeItems,
+					Items: volumeItems,
 				},
 			},
 		})
@@ -1673,6 +2651,69 @@ func isPodFailed(p *corev1.Pod) bool {
 	return p.Status.Phase == corev1.PodFailed
 }
 
+// podExitCode returns the exit code and the name of the first container of the
+// Pod that terminated with a non-zero exit code.
+func podExitCode(p *corev1.Pod) (int32, string, bool) {
+	for _, s := range p.Status.ContainerStatuses {
+		if t := s.State.Terminated; t != nil && t.ExitCode != 0 {
+			return t.ExitCode, s.Name, true
+		}
+	}
+	return 0, "", false
+}
+
+// podFailureTime returns the time the pod failed: when its last container
+// terminated or, for pods failing without a terminated container such as
+// evicted ones, when it stopped being ready.
+func podFailureTime(pod *corev1.Pod) metav1.Time {
+	var failureTime metav1.Time
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil && failureTime.Before(&terminated.FinishedAt) {
+			failureTime = terminated.FinishedAt
+		}
+	}
+	if failureTime.IsZero() {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady {
+				failureTime = cond.LastTransitionTime
+			}
+		}
+	}
+	return failureTime
+}
+
+// retryableExitCodes returns the sorted exit codes which are retryable under
+// the ExitCode restart policy. It defaults to 128-255.
+func retryableExitCodes(spec *kubeflow.ReplicaSpec) []int32 {
+	if len(spec.RetryableExitCodes) != 0 {
+		codes := slices.Clone(spec.RetryableExitCodes)
+		slices.Sort(codes)
+		return codes
+	}
+	codes := make([]int32, 0, 128)
+	for code := int32(128); code <= 255; code++ {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func isRetryableExitCode(spec *kubeflow.ReplicaSpec, exitCode int32) bool {
+	if len(spec.RetryableExitCodes) != 0 {
+		return slices.Contains(spec.RetryableExitCodes, exitCode)
+	}
+	return exitCode >= 128 && exitCode <= 255
+}
+
+func countFailedPods(pods []*corev1.Pod) int {
+	failed := 0
+	for _, p := range pods {
+		if isPodFailed(p) {
+			failed++
+		}
+	}
+	return failed
+}
+
 func isCleanUpPods(cleanPodPolicy *kubeflow.CleanPodPolicy) bool {
 	if *cleanPodPolicy == kubeflow.CleanPodPolicyAll || *cleanPodPolicy == kubeflow.CleanPodPolicyRunning {
 		return true
@@ -1693,6 +2734,69 @@ func workerSelector(mpiJobName string) (labels.Selector, error) {
 	return labels.ValidatedSelectorFromSet(set)
 }
 
+// workerSelectorString returns the selector of the worker Pods of a worker
+// group in the serialized form reported in the status of the GroupJob. The
+// selector of the Worker group excludes the other groups instead of requiring
+// the replica type label, which the Pods created before worker groups lack.
+func workerSelectorString(mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType) string {
+	set := defaultLabels(mpiJob.Name, worker)
+	if rType != kubeflow.MPIReplicaTypeWorker {
+		set[kubeflow.ReplicaTypeLabel] = workerGroupName(rType)
+		return labels.SelectorFromSet(set).String()
+	}
+	selector := labels.SelectorFromSet(set)
+	var others []string
+	for _, group := range workerGroups(mpiJob) {
+		if group != kubeflow.MPIReplicaTypeWorker {
+			others = append(others, workerGroupName(group))
+		}
+	}
+	if len(others) > 0 {
+		// The group names are valid label values.
+		req, _ := labels.NewRequirement(kubeflow.ReplicaTypeLabel, selection.NotIn, others)
+		selector = selector.Add(*req)
+	}
+	return selector.String()
+}
+
+// isElastic returns whether the number of workers of the GroupJob can vary
+// between minReplicas and maxReplicas.
+func isElastic(job *kubeflow.GroupJob) bool {
+	workerSpec := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	return workerSpec != nil && (workerSpec.MinReplicas != nil || workerSpec.MaxReplicas != nil)
+}
+
+// minWorkerReplicas returns the minimum number of workers for the GroupJob to
+// run, which is minReplicas for elastic GroupJobs and all the workers otherwise.
+func minWorkerReplicas(job *kubeflow.GroupJob) int32 {
+	workerSpec := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	if workerSpec != nil && workerSpec.MinReplicas != nil {
+		return *workerSpec.MinReplicas
+	}
+	return workerReplicas(job)
+}
+
+// minTotalWorkers returns the minimum number of workers across all the worker
+// groups for the GroupJob to run. Only the Worker group can be elastic.
+func minTotalWorkers(job *kubeflow.GroupJob) int32 {
+	total := minWorkerReplicas(job)
+	for _, rType := range workerGroups(job) {
+		if rType != kubeflow.MPIReplicaTypeWorker {
+			total += ptr.Deref(job.Spec.MPIReplicaSpecs[rType].Replicas, 0)
+		}
+	}
+	return total
+}
+
+// workersNeeded returns the number of workers that must be running, or ready,
+// for the GroupJob to start.
+func workersNeeded(job *kubeflow.GroupJob, workers []*corev1.Pod) int {
+	if isElastic(job) {
+		return int(minTotalWorkers(job))
+	}
+	return len(workers)
+}
+
 func workerReplicas(job *kubeflow.GroupJob) int32 {
 	workerSpec := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
 	if workerSpec != nil && workerSpec.Replicas != nil {
@@ -1703,9 +2807,17 @@ func workerReplicas(job *kubeflow.GroupJob) int32 {
 
 func (c *GroupJobController) setupSSHOnPod(podSpec *corev1.PodSpec, job *kubeflow.GroupJob) {
 	var mode *int32
-	if job.Spec.SSHAuthMountPath == rootSSHPath {
+	if isSSHNonRoot(job) {
+		// The files are owned by root, so they must be readable by the
+		// user of the containers.
+		mode = ptr.To[int32](0444)
+	} else if job.Spec.SSHAuthMountPath == rootSSHPath {
 		mode = ptr.To[int32](0600)
 	}
+	secretName, items := job.Name+sshAuthSecretSuffix, sshVolumeItems
+	if job.Spec.SSHAuthSecretName != "" {
+		secretName, items = job.Spec.SSHAuthSecretName, sshClientVolumeItems
+	}
 	mainContainer := &podSpec.Containers[0]
 	podSpec.Volumes = append(podSpec.Volumes,
 		corev1.Volume{
@@ -1713,8 +2825,8 @@ func (c *GroupJobController) setupSSHOnPod(podSpec *corev1.PodSpec, job *kubeflo
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					DefaultMode: mode,
-					SecretName:  job.Name + sshAuthSecretSuffix,
-					Items:       sshVolumeItems,
+					SecretName:  secretName,
+					Items:       items,
 				},
 			},
 		})
@@ -1726,6 +2838,25 @@ func (c *GroupJobController) setupSSHOnPod(podSpec *corev1.PodSpec, job *kubeflo
 		})
 }
 
+// restrictSSHPrivateKeys makes the private keys mounted in a worker readable
+// by root only, as sshd runs as root there and rejects a host key that others
+// can read. The files mounted under other paths than /root/.ssh are readable
+// by all by default.
+func restrictSSHPrivateKeys(podSpec *corev1.PodSpec) {
+	for _, volume := range podSpec.Volumes {
+		if volume.Name != sshAuthVolume || volume.Secret == nil || volume.Secret.DefaultMode != nil {
+			continue
+		}
+		items := slices.Clone(volume.Secret.Items)
+		for i := range items {
+			if items[i].Key == corev1.SSHAuthPrivateKey || items[i].Key == sshHostPrivateKey {
+				items[i].Mode = ptr.To[int32](0600)
+			}
+		}
+		volume.Secret.Items = items
+	}
+}
+
 func ownerReferenceAndGVK(object metav1.Object) (*metav1.OwnerReference, schema.GroupVersionKind, error) {
 	ownerRef := metav1.GetControllerOf(object)
 	if ownerRef == nil {
@@ -0,0 +1,162 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// finishedCleanupPolicy returns the cleanup policies of mpiJob for its
+// workers, its launcher and its resources, with the unset ones following the
+// CleanPodPolicy.
+func finishedCleanupPolicy(mpiJob *kubeflow.GroupJob) (workers, launcher, resources kubeflow.CleanPodPolicy) {
+	workers = *mpiJob.Spec.RunPolicy.CleanPodPolicy
+	launcher = kubeflow.CleanPodPolicyNone
+	if isCleanUpPods(&workers) {
+		// The CleanPodPolicy only ever deleted the active launcher Job.
+		launcher = kubeflow.CleanPodPolicyRunning
+	}
+	resources = kubeflow.CleanPodPolicyNone
+	if policy := mpiJob.Spec.RunPolicy.CleanupPolicy; policy != nil {
+		if policy.Workers != nil {
+			workers = *policy.Workers
+		}
+		if policy.Launcher != nil {
+			launcher = *policy.Launcher
+		}
+		if policy.Resources != nil {
+			resources = *policy.Resources
+		}
+	}
+	return workers, launcher, resources
+}
+
+// cleansUpFinishedWorkers returns whether the workers of mpiJob are deleted
+// once it finished, in which case cleanUpFinishedGroupJob updates its status.
+func cleansUpFinishedWorkers(mpiJob *kubeflow.GroupJob) bool {
+	workers, _, _ := finishedCleanupPolicy(mpiJob)
+	return isCleanUpPods(&workers)
+}
+
+// cleanUpFinishedGroupJob deletes the workers, the launcher and the resources
+// of the finished mpiJob according to its cleanup policy, and updates its
+// status if it deleted the workers. A launcher Job the policy keeps is
+// suspended if it is still active.
+func (c *GroupJobController) cleanUpFinishedGroupJob(mpiJob *kubeflow.GroupJob) error {
+	workers, launcher, resources := finishedCleanupPolicy(mpiJob)
+	if isCleanUpPods(&workers) {
+		if err := cleanUpWorkerPods(mpiJob, c, workers); err != nil {
+			return err
+		}
+	}
+	if isCleanUpPods(&launcher) {
+		if err := c.deleteActiveLauncherJob(mpiJob); err != nil {
+			return err
+		}
+		if launcher == kubeflow.CleanPodPolicyAll {
+			if err := c.deleteFinishedLauncherPods(mpiJob); err != nil {
+				return err
+			}
+		}
+	} else if err := c.suspendActiveLauncherJob(mpiJob); err != nil {
+		return err
+	}
+	if resources == kubeflow.CleanPodPolicyAll {
+		if err := c.deleteGroupResources(mpiJob); err != nil {
+			return err
+		}
+	}
+	if isCleanUpPods(&workers) {
+		return c.updateStatusHandler(mpiJob)
+	}
+	return nil
+}
+
+// deleteFinishedLauncherPods deletes the pods of the finished launcher Job of
+// mpiJob. The Job itself is kept, with its status.
+func (c *GroupJobController) deleteFinishedLauncherPods(mpiJob *kubeflow.GroupJob) error {
+	launcher, err := c.getLauncherJob(mpiJob)
+	if err != nil || launcher == nil || !isJobFinished(launcher) {
+		return err
+	}
+	pods, err := c.jobPods(launcher)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		err := c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteGroupResources deletes the Service, the ConfigMap, the SSH Secret, the
+// objects of the Exec bootstrap mode and the PodGroup of mpiJob.
+func (c *GroupJobController) deleteGroupResources(mpiJob *kubeflow.GroupJob) error {
+	ns := mpiJob.Namespace
+	core := c.kubeClient.CoreV1()
+	rbac := c.kubeClient.RbacV1()
+	if err := deleteControlledObject(mpiJob, mpiJob.Name, c.serviceLister.Services(ns).Get, core.Services(ns).Delete); err != nil {
+		return err
+	}
+	if err := deleteControlledObject(mpiJob, mpiJob.Name+configSuffix, c.configMapLister.ConfigMaps(ns).Get, core.ConfigMaps(ns).Delete); err != nil {
+		return err
+	}
+	// A Secret referenced by sshAuthSecretName isn't controlled by the
+	// GroupJob, so it is kept.
+	if err := deleteControlledObject(mpiJob, mpiJob.Name+sshAuthSecretSuffix, c.secretLister.Secrets(ns).Get, core.Secrets(ns).Delete); err != nil {
+		return err
+	}
+	if err := deleteControlledObject(mpiJob, mpiJob.Name+launcherSuffix, c.roleBindingLister.RoleBindings(ns).Get, rbac.RoleBindings(ns).Delete); err != nil {
+		return err
+	}
+	if err := deleteControlledObject(mpiJob, mpiJob.Name+launcherSuffix, c.roleLister.Roles(ns).Get, rbac.Roles(ns).Delete); err != nil {
+		return err
+	}
+	if err := deleteControlledObject(mpiJob, mpiJob.Name+launcherSuffix, c.serviceAccountLister.ServiceAccounts(ns).Get, core.ServiceAccounts(ns).Delete); err != nil {
+		return err
+	}
+	return c.cleanUpPodGroups(mpiJob)
+}
+
+// deleteControlledObject deletes the object with the given name if it exists
+// in the cache, is controlled by mpiJob and is not already being deleted.
+func deleteControlledObject[T metav1.Object](mpiJob *kubeflow.GroupJob, name string,
+	get func(string) (T, error), del func(context.Context, string, metav1.DeleteOptions) error) error {
+	obj, err := get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(obj, mpiJob) || obj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	if err := del(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
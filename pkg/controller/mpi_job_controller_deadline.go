@@ -0,0 +1,58 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// checkActiveDeadline fails mpiJob once it was active for longer than its
+// activeDeadlineSeconds, and returns true if it did. The deadline covers the
+// whole group, from the start time, which is reset when the GroupJob is
+// resumed, so that the time spent suspended doesn't count.
+func (c *GroupJobController) checkActiveDeadline(mpiJob *kubeflow.GroupJob) (bool, error) {
+	seconds := mpiJob.Spec.RunPolicy.ActiveDeadlineSeconds
+	if seconds == nil || mpiJob.Status.StartTime == nil || isFinished(mpiJob.Status) {
+		return false, nil
+	}
+	// A GroupJob just resumed keeps the Suspended condition, and its previous
+	// start time, until its status is updated at the end of the sync.
+	if isGroupJobSuspended(mpiJob) || hasCondition(mpiJob.Status, kubeflow.JobSuspended) {
+		return false, nil
+	}
+	deadline := time.Duration(*seconds) * time.Second
+	if active := c.clock.Since(mpiJob.Status.StartTime.Time); active < deadline {
+		c.enqueueAfter(mpiJob, deadline-active)
+		return false, nil
+	}
+
+	msg := fmt.Sprintf("GroupJob %s/%s was active for longer than its deadline of %v", mpiJob.Namespace, mpiJob.Name, deadline)
+	klog.Infof("%s", msg)
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobDeadlineExceededReason, msg)
+	if mpiJob.Status.CompletionTime == nil {
+		now := metav1.NewTime(c.clock.Now())
+		mpiJob.Status.CompletionTime = &now
+	}
+	updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobDeadlineExceededReason, msg)
+	mpiJobsFailureCount.Inc()
+	return true, nil
+}
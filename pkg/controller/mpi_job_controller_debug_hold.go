@@ -0,0 +1,107 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// mpiJobDebugHoldReason is the reason of the DebugHold condition while
+	// the pods are held.
+	mpiJobDebugHoldReason = "DebugHold"
+	// mpiJobDebugHoldExpiredReason is the reason of the DebugHold condition
+	// once the hold expired.
+	mpiJobDebugHoldExpiredReason = "DebugHoldExpired"
+	// mpiJobDebugHoldReleasedReason is the reason of the DebugHold condition
+	// once neither the annotation nor the run policy asks for a hold anymore.
+	mpiJobDebugHoldReleasedReason = "DebugHoldReleased"
+)
+
+// debugHold returns how long the pods of mpiJob are held after a failure,
+// from its annotation or else its run policy. Validation rejects annotations
+// that are not durations.
+func debugHold(mpiJob *kubeflow.GroupJob) time.Duration {
+	if value, ok := mpiJob.Annotations[kubeflow.DebugHoldAnnotation]; ok {
+		d, _ := time.ParseDuration(value)
+		return d
+	}
+	return time.Duration(ptr.Deref(mpiJob.Spec.RunPolicy.DebugHoldSeconds, 0)) * time.Second
+}
+
+// holdForDebugging returns whether the pods of mpiJob, which failed as
+// described by cause, are held for debugging, in which case it must neither
+// be restarted nor cleaned up in this sync. It updates the status of mpiJob
+// when the hold starts or ends, and the sync resumes once the update is
+// observed.
+func (c *GroupJobController) holdForDebugging(mpiJob *kubeflow.GroupJob, cause string) (bool, error) {
+	held, changed := c.updateDebugHold(mpiJob, cause)
+	if changed {
+		return true, c.updateStatusHandler(mpiJob)
+	}
+	return held, nil
+}
+
+// updateDebugHold returns whether the pods of mpiJob, which failed as
+// described by cause, are held for debugging, and whether it changed the
+// DebugHold condition. The hold starts the first time it is checked. A hold
+// is only granted once per failure: the DebugHold condition is removed when
+// the failed pods are restarted.
+func (c *GroupJobController) updateDebugHold(mpiJob *kubeflow.GroupJob, cause string) (held, changed bool) {
+	hold := debugHold(mpiJob)
+	cond := getCondition(mpiJob.Status, kubeflow.JobDebugHold)
+	if cond == nil && hold == 0 {
+		return false, false
+	}
+	if cond != nil && cond.Status != corev1.ConditionTrue {
+		// The hold for this failure was already released.
+		return false, false
+	}
+
+	if cond == nil {
+		now := c.clock.Now()
+		msg := fmt.Sprintf("Holding the pods of GroupJob %s/%s for debugging until %s: %s", mpiJob.Namespace, mpiJob.Name, now.Add(hold).UTC().Format(time.RFC3339), cause)
+		klog.Infof("%s", msg)
+		c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobDebugHoldReason, msg)
+		newCond := newCondition(kubeflow.JobDebugHold, corev1.ConditionTrue, mpiJobDebugHoldReason, msg)
+		newCond.LastTransitionTime = metav1.NewTime(now)
+		setCondition(&mpiJob.Status, newCond)
+		c.enqueueAfter(mpiJob, hold)
+		return true, true
+	}
+
+	// The expiry follows the current hold, so that changing the annotation
+	// extends or shortens the hold, and removing it releases the hold it
+	// asked for.
+	if held := c.clock.Since(cond.LastTransitionTime.Time); held < hold {
+		c.enqueueAfter(mpiJob, hold-held)
+		return true, false
+	}
+	reason, msg := mpiJobDebugHoldExpiredReason, fmt.Sprintf("The debug hold of GroupJob %s/%s expired", mpiJob.Namespace, mpiJob.Name)
+	if hold == 0 {
+		reason, msg = mpiJobDebugHoldReleasedReason, fmt.Sprintf("The debug hold of GroupJob %s/%s was released", mpiJob.Namespace, mpiJob.Name)
+	}
+	c.recorder.Event(mpiJob, corev1.EventTypeNormal, reason, msg)
+	updateGroupJobConditions(mpiJob, kubeflow.JobDebugHold, corev1.ConditionFalse, reason, msg)
+	return false, true
+}
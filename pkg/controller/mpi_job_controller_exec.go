@@ -0,0 +1,222 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	execAgentScriptName = "kubexec.sh"
+
+	// execAgentScript is the rsh agent of the launcher in the Exec bootstrap
+	// mode. It is called as `kubexec.sh <host> <command...>` and runs the
+	// command in the worker Pod named after the first label of the host.
+	execAgentScript = `#!/bin/sh
+host="${1%%.*}"
+shift
+if [ "$host" = "$(hostname)" ]; then
+  exec /bin/sh -c "$*"
+fi
+exec kubectl exec "$host" -- /bin/sh -c "$*"
+`
+)
+
+var (
+	execAgentVolumeItem = corev1.KeyToPath{
+		Key:  execAgentScriptName,
+		Path: execAgentScriptName,
+		Mode: ptr.To[int32](0555),
+	}
+	// execWorkerCommand keeps the workers running when they don't have a
+	// command, as there is no sshd to run.
+	execWorkerCommand = []string{"sleep", "infinity"}
+)
+
+// isExecBootstrap returns whether the launcher of mpiJob starts the processes
+// on the workers through the Kubernetes exec API instead of SSH.
+func isExecBootstrap(mpiJob *kubeflow.GroupJob) bool {
+	return mpiJob.Spec.BootstrapMode == kubeflow.BootstrapModeExec
+}
+
+// getOrCreateExecBootstrap makes sure that the ServiceAccount of the launcher
+// exists and can exec into the current workers of the GroupJob.
+func (c *GroupJobController) getOrCreateExecBootstrap(mpiJob *kubeflow.GroupJob) error {
+	if _, err := c.getOrCreateLauncherServiceAccount(mpiJob); err != nil {
+		return fmt.Errorf("getting or creating launcher ServiceAccount: %w", err)
+	}
+	if _, err := c.getOrCreateLauncherRole(mpiJob); err != nil {
+		return fmt.Errorf("getting or creating launcher Role: %w", err)
+	}
+	if _, err := c.getOrCreateLauncherRoleBinding(mpiJob); err != nil {
+		return fmt.Errorf("getting or creating launcher RoleBinding: %w", err)
+	}
+	return nil
+}
+
+func (c *GroupJobController) getOrCreateLauncherServiceAccount(mpiJob *kubeflow.GroupJob) (*corev1.ServiceAccount, error) {
+	sa, err := c.serviceAccountLister.ServiceAccounts(mpiJob.Namespace).Get(mpiJob.Name + launcherSuffix)
+	if apierrors.IsNotFound(err) {
+		return c.kubeClient.CoreV1().ServiceAccounts(mpiJob.Namespace).Create(context.TODO(), newLauncherServiceAccount(mpiJob), metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(sa, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, sa.Name, sa.Kind)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, errors.New(msg)
+	}
+	return sa, nil
+}
+
+func (c *GroupJobController) getOrCreateLauncherRole(mpiJob *kubeflow.GroupJob) (*rbacv1.Role, error) {
+	newRole := newLauncherRole(mpiJob)
+	role, err := c.roleLister.Roles(mpiJob.Namespace).Get(newRole.Name)
+	if apierrors.IsNotFound(err) {
+		return c.kubeClient.RbacV1().Roles(mpiJob.Namespace).Create(context.TODO(), newRole, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(role, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, role.Name, role.Kind)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, errors.New(msg)
+	}
+
+	// The workers change when the GroupJob is scaled.
+	if !equality.Semantic.DeepEqual(role.Rules, newRole.Rules) {
+		role = role.DeepCopy()
+		role.Rules = newRole.Rules
+		return c.kubeClient.RbacV1().Roles(mpiJob.Namespace).Update(context.TODO(), role, metav1.UpdateOptions{})
+	}
+	return role, nil
+}
+
+func (c *GroupJobController) getOrCreateLauncherRoleBinding(mpiJob *kubeflow.GroupJob) (*rbacv1.RoleBinding, error) {
+	rb, err := c.roleBindingLister.RoleBindings(mpiJob.Namespace).Get(mpiJob.Name + launcherSuffix)
+	if apierrors.IsNotFound(err) {
+		return c.kubeClient.RbacV1().RoleBindings(mpiJob.Namespace).Create(context.TODO(), newLauncherRoleBinding(mpiJob), metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(rb, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, rb.Name, rb.Kind)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, errors.New(msg)
+	}
+	return rb, nil
+}
+
+// newLauncherServiceAccount creates the ServiceAccount the launcher of an
+// GroupJob in the Exec bootstrap mode runs as.
+func newLauncherServiceAccount(mpiJob *kubeflow.GroupJob) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mpiJob.Name + launcherSuffix,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+	}
+}
+
+// newLauncherRole creates the Role allowing the launcher to exec into the
+// workers of the GroupJob, and only into them.
+func newLauncherRole(mpiJob *kubeflow.GroupJob) *rbacv1.Role {
+	var podNames []string
+	for _, rType := range workerGroups(mpiJob) {
+		for i := 0; i < int(ptr.Deref(mpiJob.Spec.MPIReplicaSpecs[rType].Replicas, 0)); i++ {
+			podNames = append(podNames, groupWorkerName(mpiJob, rType, i))
+		}
+	}
+	var rules []rbacv1.PolicyRule
+	// A Role with empty resourceNames would grant access to all the Pods.
+	if len(podNames) != 0 {
+		rules = []rbacv1.PolicyRule{
+			{
+				Verbs:         []string{"get"},
+				APIGroups:     []string{""},
+				Resources:     []string{"pods"},
+				ResourceNames: podNames,
+			},
+			{
+				Verbs:         []string{"create"},
+				APIGroups:     []string{""},
+				Resources:     []string{"pods/exec"},
+				ResourceNames: podNames,
+			},
+		}
+	}
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mpiJob.Name + launcherSuffix,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+		Rules: rules,
+	}
+}
+
+// newLauncherRoleBinding binds the launcher Role to its ServiceAccount.
+func newLauncherRoleBinding(mpiJob *kubeflow.GroupJob) *rbacv1.RoleBinding {
+	name := mpiJob.Name + launcherSuffix
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      name,
+				Namespace: mpiJob.Namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// mpiJobPreemptedReason is the reason of the Failed condition when a
+	// preempted worker fails the GroupJob.
+	mpiJobPreemptedReason = "GroupJobPreempted"
+	// mpiJobOOMKilledReason is the reason of the Failed condition when an
+	// OOM-killed worker fails the GroupJob.
+	mpiJobOOMKilledReason = "GroupJobOOMKilled"
+	// mpiJobNodeLostReason is the reason of the Failed condition when a worker
+	// that lost its node fails the GroupJob.
+	mpiJobNodeLostReason = "GroupJobNodeLost"
+)
+
+// Reasons of the DisruptionTarget pod condition which are not part of the
+// core API, and of failed pods.
+const (
+	podReasonEvictionByEvictionAPI  = "EvictionByEvictionAPI"
+	podReasonDeletionByTaintManager = "DeletionByTaintManager"
+	podReasonDeletionByPodGC        = "DeletionByPodGC"
+	podReasonEvicted                = "Evicted"
+	podReasonNodeLost               = "NodeLost"
+	containerReasonOOMKilled        = "OOMKilled"
+)
+
+// podFailureCause classifies the failure of a failed pod. The DisruptionTarget
+// condition takes precedence, as a disrupted pod can also have containers
+// terminated with an error.
+func podFailureCause(pod *corev1.Pod) kubeflow.FailureCause {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type != corev1.DisruptionTarget || cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Reason {
+		case corev1.PodReasonPreemptionByScheduler:
+			return kubeflow.FailureCausePreempted
+		case corev1.PodReasonTerminationByKubelet, podReasonEvictionByEvictionAPI:
+			return kubeflow.FailureCauseEvicted
+		case podReasonDeletionByTaintManager, podReasonDeletionByPodGC:
+			return kubeflow.FailureCauseNodeLost
+		}
+	}
+	switch pod.Status.Reason {
+	case podReasonEvicted:
+		return kubeflow.FailureCauseEvicted
+	case podReasonNodeLost:
+		return kubeflow.FailureCauseNodeLost
+	}
+	for _, s := range pod.Status.ContainerStatuses {
+		if t := s.State.Terminated; t != nil && t.Reason == containerReasonOOMKilled {
+			return kubeflow.FailureCauseOOMKilled
+		}
+	}
+	return kubeflow.FailureCauseError
+}
+
+// failureAction returns the action of the failure policy of mpiJob for the
+// cause, or an empty action if no rule matches it.
+func failureAction(mpiJob *kubeflow.GroupJob, cause kubeflow.FailureCause) kubeflow.FailureAction {
+	for _, rule := range mpiJob.Spec.RunPolicy.FailurePolicy {
+		if rule.Cause == cause {
+			return rule.Action
+		}
+	}
+	return ""
+}
+
+// failureReason returns the reason of the Failed condition for workers
+// failing with the cause.
+func failureReason(cause kubeflow.FailureCause) string {
+	switch cause {
+	case kubeflow.FailureCausePreempted:
+		return mpiJobPreemptedReason
+	case kubeflow.FailureCauseEvicted:
+		return mpiJobEvict
+	case kubeflow.FailureCauseOOMKilled:
+		return mpiJobOOMKilledReason
+	case kubeflow.FailureCauseNodeLost:
+		return mpiJobNodeLostReason
+	}
+	return mpiJobFailedReason
+}
+
+// describeFailure returns what happened to a pod that failed with the cause,
+// as in "worker pod foo was evicted".
+func describeFailure(cause kubeflow.FailureCause) string {
+	switch cause {
+	case kubeflow.FailureCausePreempted:
+		return "was preempted"
+	case kubeflow.FailureCauseEvicted:
+		return "was evicted"
+	case kubeflow.FailureCauseOOMKilled:
+		return "was OOM-killed"
+	case kubeflow.FailureCauseNodeLost:
+		return "lost its node"
+	}
+	return "failed"
+}
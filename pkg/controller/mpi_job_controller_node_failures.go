@@ -0,0 +1,169 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// mpiJobNodeAvoidedReason is the reason of the event emitted when a node
+	// reaches the NodeFailureLimit.
+	mpiJobNodeAvoidedReason = "NodeAvoided"
+)
+
+var nodeWorkerFailuresCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "group_operator_node_worker_failures_total",
+	Help: "Counts number of Group job worker failures by node",
+}, []string{"node"})
+
+// isNodeFailure returns whether the failure of the pod is attributable to its
+// node, rather than to the application or to the scheduler.
+func isNodeFailure(pod *corev1.Pod) bool {
+	switch podFailureCause(pod) {
+	case kubeflow.FailureCauseNodeLost, kubeflow.FailureCauseEvicted:
+		return true
+	}
+	return false
+}
+
+// recordNodeFailures adds the node failures of the given workers to the
+// nodeFailures of the status, and returns the node of each failure it added.
+// A failure is recorded once, as only the workers that failed after the last
+// failure recorded on their node count, which also merges the workers failing
+// on a node at the same time into one failure.
+func recordNodeFailures(mpiJob *kubeflow.GroupJob, workers []*corev1.Pod) []string {
+	type nodeFailure struct {
+		node string
+		time metav1.Time
+	}
+	var failures []nodeFailure
+	for _, pod := range workers {
+		if !isPodFailed(pod) || len(pod.Spec.NodeName) == 0 || !isNodeFailure(pod) {
+			continue
+		}
+		if failureTime := podFailureTime(pod); !failureTime.IsZero() {
+			failures = append(failures, nodeFailure{node: pod.Spec.NodeName, time: failureTime})
+		}
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].time.Before(&failures[j].time)
+	})
+	var recorded []string
+	for _, f := range failures {
+		i := slices.IndexFunc(mpiJob.Status.NodeFailures, func(nf kubeflow.NodeFailure) bool {
+			return nf.NodeName == f.node
+		})
+		if i == -1 {
+			mpiJob.Status.NodeFailures = append(mpiJob.Status.NodeFailures, kubeflow.NodeFailure{NodeName: f.node})
+			i = len(mpiJob.Status.NodeFailures) - 1
+		}
+		nf := &mpiJob.Status.NodeFailures[i]
+		if !nf.LastFailureTime.Before(&f.time) {
+			continue
+		}
+		nf.Failures++
+		nf.LastFailureTime = f.time
+		recorded = append(recorded, f.node)
+	}
+	return recorded
+}
+
+// reportNodeFailures counts the failures recorded on the given nodes, and
+// reports the nodes they made reach the NodeFailureLimit. It is called once
+// the status holding the failures is updated, so that each failure is counted
+// once.
+func (c *GroupJobController) reportNodeFailures(mpiJob *kubeflow.GroupJob, nodes []string) {
+	recorded := make(map[string]int32)
+	for _, node := range nodes {
+		nodeWorkerFailuresCount.WithLabelValues(node).Inc()
+		recorded[node]++
+	}
+	limit := mpiJob.Spec.RunPolicy.NodeFailureLimit
+	if limit == nil {
+		return
+	}
+	for _, nf := range mpiJob.Status.NodeFailures {
+		if n := recorded[nf.NodeName]; n > 0 && nf.Failures >= *limit && nf.Failures-n < *limit {
+			msg := fmt.Sprintf("Workers of GroupJob %s/%s failed %d times on node %s; the workers created again avoid it", mpiJob.Namespace, mpiJob.Name, nf.Failures, nf.NodeName)
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobNodeAvoidedReason, msg)
+		}
+	}
+}
+
+// updateStatusWithNodeFailures updates the status of mpiJob, then reports the
+// failures recorded on the given nodes.
+func (c *GroupJobController) updateStatusWithNodeFailures(mpiJob *kubeflow.GroupJob, nodes []string) error {
+	if err := c.updateStatusHandler(mpiJob); err != nil {
+		return err
+	}
+	c.reportNodeFailures(mpiJob, nodes)
+	return nil
+}
+
+// avoidedNodes returns the nodes on which the workers of mpiJob failed at
+// least NodeFailureLimit times.
+func avoidedNodes(mpiJob *kubeflow.GroupJob) []string {
+	limit := mpiJob.Spec.RunPolicy.NodeFailureLimit
+	if limit == nil {
+		return nil
+	}
+	var nodes []string
+	for _, nf := range mpiJob.Status.NodeFailures {
+		if nf.Failures >= *limit {
+			nodes = append(nodes, nf.NodeName)
+		}
+	}
+	return nodes
+}
+
+// avoidFailedNodes keeps the pod off the nodes avoided by mpiJob, adding the
+// requirement to every term of the required node affinity of the template.
+func avoidFailedNodes(podSpec *corev1.PodSpec, mpiJob *kubeflow.GroupJob) {
+	nodes := avoidedNodes(mpiJob)
+	if len(nodes) == 0 {
+		return
+	}
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(required.NodeSelectorTerms) == 0 {
+		required.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range required.NodeSelectorTerms {
+		required.NodeSelectorTerms[i].MatchFields = append(required.NodeSelectorTerms[i].MatchFields, corev1.NodeSelectorRequirement{
+			Key:      metav1.ObjectNameField,
+			Operator: corev1.NodeSelectorOpNotIn,
+			Values:   nodes,
+		})
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// mpiJobQueuedReason is added in a mpijob when it waits in the queue.
+	mpiJobQueuedReason = "Queued"
+	// mpiJobAdmittedReason is added in a mpijob when the queue admits it.
+	mpiJobAdmittedReason = "Admitted"
+	// mpiJobExceedsQueueLimitsReason is added in a queued mpijob that
+	// can't be admitted, as it alone exceeds the limits of its namespace.
+	mpiJobExceedsQueueLimitsReason = "ExceedsQueueLimits"
+	// mpiJobLeftQueueReason is added in an admitted mpijob when it's
+	// suspended, which frees its share of the limits.
+	mpiJobLeftQueueReason = "LeftQueue"
+)
+
+var (
+	// suspendPatch suspends a GroupJob.
+	suspendPatch = []byte(`{"spec":{"runPolicy":{"suspend":true}}}`)
+	// resumePatch resumes a GroupJob.
+	resumePatch = []byte(`{"spec":{"runPolicy":{"suspend":false}}}`)
+)
+
+// QueueLimits are the limits on the GroupJobs running at once in a namespace.
+// Unset limits are unlimited.
+type QueueLimits struct {
+	// MaxJobs is the number of GroupJobs.
+	MaxJobs *int32 `json:"maxJobs,omitempty"`
+	// MaxWorkers is the number of workers, across all the worker groups.
+	MaxWorkers *int32 `json:"maxWorkers,omitempty"`
+	// MaxResources are the resources requested by the launchers and the
+	// workers. Resources that aren't listed are unlimited.
+	MaxResources corev1.ResourceList `json:"maxResources,omitempty"`
+}
+
+// QueueConfig configures the queue of the GroupJobs.
+type QueueConfig struct {
+	// Default are the limits of the namespaces not listed in Namespaces.
+	Default QueueLimits `json:"default,omitempty"`
+	// Namespaces are the limits by namespace.
+	Namespaces map[string]QueueLimits `json:"namespaces,omitempty"`
+}
+
+// LoadQueueConfig reads the QueueConfig from a YAML file.
+func LoadQueueConfig(path string) (*QueueConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &QueueConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("parsing queue config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+func (q *QueueConfig) limits(namespace string) QueueLimits {
+	if limits, ok := q.Namespaces[namespace]; ok {
+		return limits
+	}
+	return q.Default
+}
+
+// queueUsage is what GroupJobs take from the limits of their namespace.
+type queueUsage struct {
+	jobs      int32
+	workers   int32
+	resources corev1.ResourceList
+}
+
+func (u *queueUsage) add(mpiJob *kubeflow.GroupJob) {
+	u.jobs++
+	if u.resources == nil {
+		u.resources = corev1.ResourceList{}
+	}
+	if launcherSpec := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]; launcherSpec != nil {
+		for _, c := range launcherSpec.Template.Spec.Containers {
+			addResources(u.resources, c.Resources, 1)
+		}
+	}
+	for _, rType := range workerGroups(mpiJob) {
+		spec := mpiJob.Spec.MPIReplicaSpecs[rType]
+		replicas := ptr.Deref(spec.Replicas, 0)
+		u.workers += replicas
+		for _, c := range spec.Template.Spec.Containers {
+			addResources(u.resources, c.Resources, int64(replicas))
+		}
+	}
+}
+
+// within returns whether the usage is within the limits.
+func (u *queueUsage) within(limits QueueLimits) bool {
+	if limits.MaxJobs != nil && u.jobs > *limits.MaxJobs {
+		return false
+	}
+	if limits.MaxWorkers != nil && u.workers > *limits.MaxWorkers {
+		return false
+	}
+	for name, limit := range limits.MaxResources {
+		if used, ok := u.resources[name]; ok && used.Cmp(limit) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isQueued returns whether mpiJob waits in the queue.
+func isQueued(mpiJob *kubeflow.GroupJob) bool {
+	queued := getCondition(mpiJob.Status, kubeflow.JobQueued)
+	return queued != nil && queued.Status == corev1.ConditionTrue && isGroupJobSuspended(mpiJob)
+}
+
+// queueGroupJob suspends a GroupJob about to start and puts it in the queue
+// of its namespace. A queued GroupJob is resumed once it's admitted: when the
+// GroupJobs ahead of it in the queue, by priority and then creation time,
+// along with it fit in the limits of the namespace. GroupJobs created
+// suspended are queued once resumed. Suspending an admitted GroupJob gives
+// up its admission, so it's queued again once resumed.
+func (c *GroupJobController) queueGroupJob(mpiJob *kubeflow.GroupJob) error {
+	queued := getCondition(mpiJob.Status, kubeflow.JobQueued)
+	isWaiting := queued != nil && queued.Status == corev1.ConditionTrue
+	isAdmitted := queued != nil && queued.Status == corev1.ConditionFalse && queued.Reason == mpiJobAdmittedReason
+	if isFinished(mpiJob.Status) || (isGroupJobSuspended(mpiJob) && !isWaiting) {
+		if isAdmitted && !isFinished(mpiJob.Status) {
+			msg := fmt.Sprintf("GroupJob %s/%s was suspended and left the queue.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionFalse, mpiJobLeftQueueReason, msg)
+		}
+		// The GroupJob doesn't take from the limits.
+		c.enqueueQueuedGroupJobs(mpiJob.Namespace, mpiJob.Name)
+		return nil
+	}
+	if isAdmitted || (queued == nil && mpiJob.Status.StartTime != nil) {
+		// The GroupJob was admitted, or it started before the queue was set up.
+		return nil
+	}
+	if !isWaiting {
+		if err := c.patchGroupJobSuspend(mpiJob, suspendPatch); err != nil {
+			return fmt.Errorf("queueing GroupJob: %w", err)
+		}
+		msg := fmt.Sprintf("GroupJob %s/%s is queued.", mpiJob.Namespace, mpiJob.Name)
+		updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+		updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+		c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobQueuedReason, msg)
+		// The GroupJobs queued before it took it as running.
+		c.enqueueQueuedGroupJobs(mpiJob.Namespace, mpiJob.Name)
+	}
+
+	limits := c.QueueConfig.limits(mpiJob.Namespace)
+	alone := queueUsage{}
+	alone.add(mpiJob)
+	if !alone.within(limits) {
+		if err := c.suspendResumedGroupJob(mpiJob); err != nil {
+			return err
+		}
+		msg := fmt.Sprintf("GroupJob %s/%s exceeds the limits of the queue of namespace %s.", mpiJob.Namespace, mpiJob.Name, mpiJob.Namespace)
+		if updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobExceedsQueueLimitsReason, msg) {
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobExceedsQueueLimitsReason, msg)
+		}
+		return nil
+	}
+	position, admit, err := c.queuePosition(mpiJob, limits)
+	if err != nil {
+		return err
+	}
+	if !admit {
+		if err := c.suspendResumedGroupJob(mpiJob); err != nil {
+			return err
+		}
+		msg := fmt.Sprintf("GroupJob %s/%s is at position %d in the queue of namespace %s.", mpiJob.Namespace, mpiJob.Name, position, mpiJob.Namespace)
+		if !updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg) {
+			updateQueuedMessage(mpiJob, msg)
+		}
+		return nil
+	}
+
+	if isGroupJobSuspended(mpiJob) {
+		if err := c.patchGroupJobSuspend(mpiJob, resumePatch); err != nil {
+			return fmt.Errorf("admitting GroupJob: %w", err)
+		}
+	}
+	msg := fmt.Sprintf("GroupJob %s/%s is admitted.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionFalse, mpiJobAdmittedReason, msg)
+	c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobAdmittedReason, msg)
+	// The GroupJobs behind it moved up.
+	c.enqueueQueuedGroupJobs(mpiJob.Namespace, mpiJob.Name)
+	return nil
+}
+
+// suspendResumedGroupJob suspends again a queued GroupJob that was resumed
+// outside of the queue and can't be admitted yet.
+func (c *GroupJobController) suspendResumedGroupJob(mpiJob *kubeflow.GroupJob) error {
+	if isGroupJobSuspended(mpiJob) {
+		return nil
+	}
+	if err := c.patchGroupJobSuspend(mpiJob, suspendPatch); err != nil {
+		return fmt.Errorf("queueing GroupJob: %w", err)
+	}
+	msg := fmt.Sprintf("GroupJob %s/%s was resumed outside of the queue and is queued again.", mpiJob.Namespace, mpiJob.Name)
+	c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobQueuedReason, msg)
+	return nil
+}
+
+// updateQueuedMessage sets the message of the Queued condition, which
+// setCondition leaves as is while the reason stays the same.
+func updateQueuedMessage(mpiJob *kubeflow.GroupJob, msg string) {
+	for i := range mpiJob.Status.Conditions {
+		cond := &mpiJob.Status.Conditions[i]
+		if cond.Type == kubeflow.JobQueued && cond.Message != msg {
+			cond.Message = msg
+			cond.LastUpdateTime = metav1.Now()
+		}
+	}
+}
+
+// queuePosition returns the position of mpiJob in the queue of its namespace
+// and whether it can be admitted. The GroupJobs that are neither queued nor
+// finished take from the limits. A GroupJob that doesn't fit blocks the ones
+// behind it, so that large GroupJobs aren't starved.
+func (c *GroupJobController) queuePosition(mpiJob *kubeflow.GroupJob, limits QueueLimits) (int, bool, error) {
+	jobs, err := c.mpiJobLister.GroupJobs(mpiJob.Namespace).List(labels.Everything())
+	if err != nil {
+		return 0, false, fmt.Errorf("listing GroupJobs: %w", err)
+	}
+	usage := queueUsage{}
+	queue := []*kubeflow.GroupJob{mpiJob}
+	for _, job := range jobs {
+		switch {
+		case job.Name == mpiJob.Name, isFinished(job.Status), job.DeletionTimestamp != nil,
+			managedByExternalController(job.Spec.RunPolicy.ManagedBy) != nil:
+		case isQueued(job):
+			alone := queueUsage{}
+			alone.add(job)
+			if alone.within(limits) {
+				queue = append(queue, job)
+			}
+		case !isGroupJobSuspended(job):
+			usage.add(job)
+		}
+	}
+	priorities := make(map[string]int32, len(queue))
+	for _, job := range queue {
+		priorities[job.Name] = c.queuePriority(job)
+	}
+	slices.SortFunc(queue, func(a, b *kubeflow.GroupJob) int {
+		if n := cmp.Compare(priorities[b.Name], priorities[a.Name]); n != 0 {
+			return n
+		}
+		if n := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	for i, job := range queue {
+		usage.add(job)
+		if !usage.within(limits) {
+			return slices.Index(queue, mpiJob) + 1, false, nil
+		}
+		if job == mpiJob {
+			return i + 1, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// queuePriority returns the value of the PriorityClass of mpiJob, chosen as
+// for the PodGroup, or 0.
+func (c *GroupJobController) queuePriority(mpiJob *kubeflow.GroupJob) int32 {
+	name := calculatePriorityClassName(mpiJob.Spec.MPIReplicaSpecs, mpiJob.Spec.RunPolicy.SchedulingPolicy)
+	if len(name) == 0 {
+		return 0
+	}
+	priorityClass, err := c.priorityClassLister.Get(name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Warningf("Ignore priority class %q of GroupJob %s/%s: %v", name, mpiJob.Namespace, mpiJob.Name, err)
+		}
+		return 0
+	}
+	return priorityClass.Value
+}
+
+// patchGroupJobSuspend patches the suspension of mpiJob and sets it in the
+// in-memory copy, from which the status is updated at the end of the sync.
+func (c *GroupJobController) patchGroupJobSuspend(mpiJob *kubeflow.GroupJob, patch []byte) error {
+	patched, err := c.kubeflowClient.KubeflowV2beta1().GroupJobs(mpiJob.Namespace).Patch(context.TODO(), mpiJob.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	mpiJob.ResourceVersion = patched.ResourceVersion
+	mpiJob.Spec.RunPolicy.Suspend = patched.Spec.RunPolicy.Suspend
+	return nil
+}
+
+// enqueueQueuedGroupJobs enqueues the queued GroupJobs of a namespace, but
+// the one named except, so that their position is updated and they're
+// admitted when they fit.
+func (c *GroupJobController) enqueueQueuedGroupJobs(namespace, except string) {
+	jobs, err := c.mpiJobLister.GroupJobs(namespace).List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list GroupJobs of namespace %s: %v", namespace, err)
+		return
+	}
+	for _, job := range jobs {
+		if job.Name == except || !isQueued(job) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(job)
+		if err != nil {
+			klog.Errorf("Failed to get key of GroupJob %s/%s: %v", job.Namespace, job.Name, err)
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+// deleteGroupJob frees the limits taken by a deleted GroupJob.
+func (c *GroupJobController) deleteGroupJob(obj interface{}) {
+	if c.QueueConfig == nil {
+		return
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if mpiJob, ok := obj.(*kubeflow.GroupJob); ok {
+		c.enqueueQueuedGroupJobs(mpiJob.Namespace, mpiJob.Name)
+	}
+}
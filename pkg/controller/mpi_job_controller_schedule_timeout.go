@@ -0,0 +1,112 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// scheduleTimeout returns the time the pods of mpiJob can stay unscheduled,
+// or false if there is no limit.
+func scheduleTimeout(mpiJob *kubeflow.GroupJob) (time.Duration, bool) {
+	policy := mpiJob.Spec.RunPolicy.SchedulingPolicy
+	if policy == nil || ptr.Deref(policy.ScheduleTimeoutSeconds, 0) == 0 {
+		return 0, false
+	}
+	return time.Duration(*policy.ScheduleTimeoutSeconds) * time.Second, true
+}
+
+// unscheduledSince returns the creation time of the oldest of the pods that
+// are not bound to a node yet, or false if all of them are.
+func unscheduledSince(pods []*corev1.Pod) (time.Time, bool) {
+	var since time.Time
+	for _, pod := range pods {
+		if pod == nil || pod.DeletionTimestamp != nil || pod.Spec.NodeName != "" || !isPodPending(pod) {
+			continue
+		}
+		if since.IsZero() || pod.CreationTimestamp.Time.Before(since) {
+			since = pod.CreationTimestamp.Time
+		}
+	}
+	return since, !since.IsZero()
+}
+
+// checkScheduleTimeout applies the schedule timeout action of mpiJob when one
+// of its pods stayed unscheduled for longer than the schedule timeout. This
+// doesn't depend on the gang-scheduler, if any, as the pods are only
+// bound once the whole PodGroup can be.
+func (c *GroupJobController) checkScheduleTimeout(mpiJob *kubeflow.GroupJob, launcher *batchv1.Job, workers []*corev1.Pod) error {
+	timeout, ok := scheduleTimeout(mpiJob)
+	if !ok || isGroupJobSuspended(mpiJob) || isFinished(mpiJob.Status) {
+		return nil
+	}
+	pods := slices.Clone(workers)
+	if launcher != nil && !isJobFinished(launcher) {
+		launcherPods, err := c.jobPods(launcher)
+		if err != nil {
+			return fmt.Errorf("checking launcher pods scheduled: %w", err)
+		}
+		pods = append(pods, launcherPods...)
+	}
+	since, ok := unscheduledSince(pods)
+	if !ok {
+		return nil
+	}
+	if pending := c.clock.Since(since); pending < timeout {
+		c.enqueueAfter(mpiJob, timeout-pending)
+		return nil
+	}
+
+	msg := fmt.Sprintf("GroupJob %s/%s has pods that stayed unscheduled for more than %v", mpiJob.Namespace, mpiJob.Name, timeout)
+	klog.Infof("%s", msg)
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobScheduleTimeoutReason, msg)
+	if mpiJob.Spec.RunPolicy.SchedulingPolicy.ScheduleTimeoutAction == kubeflow.ScheduleTimeoutActionRequeue {
+		return c.suspendOnScheduleTimeout(mpiJob, msg)
+	}
+	if mpiJob.Status.CompletionTime == nil {
+		now := metav1.Now()
+		mpiJob.Status.CompletionTime = &now
+	}
+	if updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobScheduleTimeoutReason, msg) {
+		mpiJobsFailureCount.Inc()
+	}
+	return nil
+}
+
+// suspendOnScheduleTimeout suspends mpiJob, so that its pods and PodGroup
+// are deleted until it is resumed, by a user or a queueing system. With the
+// queue of the operator, mpiJob goes back in the queue.
+func (c *GroupJobController) suspendOnScheduleTimeout(mpiJob *kubeflow.GroupJob, msg string) error {
+	if err := c.patchGroupJobSuspend(mpiJob, suspendPatch); err != nil {
+		return fmt.Errorf("suspending GroupJob: %w", err)
+	}
+	updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobScheduleTimeoutReason, msg)
+	if c.QueueConfig != nil {
+		updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+		// The GroupJobs queued behind it can take its share.
+		c.enqueueQueuedGroupJobs(mpiJob.Namespace, mpiJob.Name)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const sshdConfigName = "sshd_config"
+
+var sshdConfigVolumeItem = corev1.KeyToPath{
+	Key:  sshdConfigName,
+	Path: sshdConfigName,
+	Mode: ptr.To[int32](0444),
+}
+
+// isSSHNonRoot returns whether the workers of mpiJob run sshd as the user of
+// their containers.
+func isSSHNonRoot(mpiJob *kubeflow.GroupJob) bool {
+	return !isExecBootstrap(mpiJob) && ptr.Deref(mpiJob.Spec.SSHRunAsNonRoot, false)
+}
+
+// sshPort returns the port sshd listens on in the non-root mode.
+func sshPort(mpiJob *kubeflow.GroupJob) int32 {
+	return ptr.Deref(mpiJob.Spec.SSHPort, kubeflow.DefaultSSHPort)
+}
+
+// newSSHDConfig returns the sshd_config of the workers in the non-root mode.
+// sshd can't write a pid file nor switch users, and the Secret volume is
+// owned by root, so the related checks are turned off. A Secret referenced by
+// spec.sshAuthSecretName has no host key, so its key pair doubles as one.
+func newSSHDConfig(mpiJob *kubeflow.GroupJob) string {
+	mountPath := mpiJob.Spec.SSHAuthMountPath
+	hostKey := sshHostKeyFile
+	if mpiJob.Spec.SSHAuthSecretName != "" {
+		hostKey = sshPrivateKeyFile
+	}
+	return fmt.Sprintf(`Port %d
+HostKey %s
+AuthorizedKeysFile %s
+PidFile none
+StrictModes no
+UsePAM no
+`, sshPort(mpiJob), path.Join(mountPath, hostKey), path.Join(mountPath, sshAuthorizedKeysFile))
+}
+
+// sshBootstrapArgs returns the arguments passed to ssh when the launcher
+// starts the processes on the workers. In the non-root mode, the hostfile has
+// no syntax for ports, so the port is passed here, along with the key and
+// known_hosts file, as the mount path might not be the home of the user.
+// Without a known_hosts file, which a Secret referenced by
+// spec.sshAuthSecretName doesn't provide, the host keys aren't checked.
+func sshBootstrapArgs(mpiJob *kubeflow.GroupJob) string {
+	args := "-o ConnectionAttempts=10"
+	mountPath := mpiJob.Spec.SSHAuthMountPath
+	if isSSHNonRoot(mpiJob) {
+		args += fmt.Sprintf(" -p %d -i %s", sshPort(mpiJob), path.Join(mountPath, sshPrivateKeyFile))
+	}
+	switch {
+	case mpiJob.Spec.SSHAuthSecretName != "":
+		args += " -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null"
+	case isSSHNonRoot(mpiJob):
+		args += " -o UserKnownHostsFile=" + path.Join(mountPath, sshKnownHostsFile)
+	}
+	return args
+}
+
+// sshdCommand returns the default command of the workers in the SSH
+// bootstrap mode.
+func sshdCommand(mpiJob *kubeflow.GroupJob) []string {
+	command := []string{"/usr/sbin/sshd", "-De"}
+	switch {
+	case isSSHNonRoot(mpiJob):
+		command = append(command, "-f", path.Join(configMountPath, sshdConfigName))
+	case mpiJob.Spec.SSHAuthSecretName == "":
+		// A Secret referenced by spec.sshAuthSecretName has no host key.
+		command = append(command, "-h", path.Join(mpiJob.Spec.SSHAuthMountPath, sshHostKeyFile))
+	}
+	return command
+}
+
+// setupSSHDConfigOnPod mounts the sshd_config of the non-root mode in the
+// main container of a worker.
+func setupSSHDConfigOnPod(podSpec *corev1.PodSpec, mpiJob *kubeflow.GroupJob) {
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: configVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: mpiJob.Name + configSuffix,
+				},
+				Items: []corev1.KeyToPath{sshdConfigVolumeItem},
+			},
+		},
+	})
+	container := &podSpec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      configVolumeName,
+		MountPath: configMountPath,
+	})
+}
+
+// setRestrictedSecurityContext fills the fields of the security contexts of
+// podSpec that the restricted Pod Security Standard requires, unless the user
+// set them.
+func setRestrictedSecurityContext(podSpec *corev1.PodSpec) {
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	podSC := podSpec.SecurityContext
+	if podSC.RunAsNonRoot == nil {
+		podSC.RunAsNonRoot = ptr.To(true)
+	}
+	if podSC.SeccompProfile == nil {
+		podSC.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	}
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			if containers[i].SecurityContext == nil {
+				containers[i].SecurityContext = &corev1.SecurityContext{}
+			}
+			sc := containers[i].SecurityContext
+			if sc.AllowPrivilegeEscalation == nil {
+				sc.AllowPrivilegeEscalation = ptr.To(false)
+			}
+			if sc.Capabilities == nil {
+				sc.Capabilities = &corev1.Capabilities{}
+			}
+			if sc.Capabilities.Drop == nil {
+				sc.Capabilities.Drop = []corev1.Capability{"ALL"}
+			}
+		}
+	}
+}
@@ -36,6 +36,29 @@ const (
 	mpiJobFailedReason = "GroupJobFailed"
 	// mpiJobEvict
 	mpiJobEvict = "GroupJobEvicted"
+	// mpiJobRestartingReason is added in a mpijob when some of its pods are restarting.
+	mpiJobRestartingReason = "GroupJobRestarting"
+	// mpiJobBackoffLimitExceededReason is added in a mpijob when it reached
+	// the backoff limit of restarts.
+	mpiJobBackoffLimitExceededReason = "GroupJobBackoffLimitExceeded"
+	// mpiJobBelowMinReplicasReason is added in an elastic mpijob when fewer
+	// than minReplicas workers did not fail.
+	mpiJobBelowMinReplicasReason = "GroupJobBelowMinReplicas"
+	// mpiJobPermanentExitCodeReason is added in a mpijob when a worker exits
+	// with a permanent exit code under the ExitCode restart policy.
+	mpiJobPermanentExitCodeReason = "GroupJobPermanentExitCode"
+	// mpiJobSpecChangeAppliedReason is added in a mpijob when its launcher
+	// Job is recreated from a changed template while suspended.
+	mpiJobSpecChangeAppliedReason = "GroupJobSpecChangeApplied"
+	// mpiJobSpecChangeIgnoredReason is added in a mpijob when a template
+	// changed while its launcher Job or worker pods are running.
+	mpiJobSpecChangeIgnoredReason = "GroupJobSpecChangeIgnored"
+	// mpiJobScheduleTimeoutReason is added in a mpijob when it is failed or
+	// suspended because its pods stayed unscheduled for too long.
+	mpiJobScheduleTimeoutReason = "ScheduleTimeout"
+	// mpiJobDeadlineExceededReason is added in a mpijob when it is failed
+	// because it was active for longer than its activeDeadlineSeconds.
+	mpiJobDeadlineExceededReason = "DeadlineExceeded"
 )
 
 // initializeGroupJobStatuses initializes the ReplicaStatuses for GroupJob.
@@ -45,6 +68,10 @@ func initializeGroupJobStatuses(mpiJob *kubeflow.GroupJob, mtype kubeflow.MPIRep
 	}
 
 	mpiJob.Status.ReplicaStatuses[mtype] = &kubeflow.ReplicaStatus{}
+	if mtype != kubeflow.MPIReplicaTypeLauncher {
+		// The selector of the Worker group backs the /scale subresource.
+		mpiJob.Status.ReplicaStatuses[mtype].Selector = workerSelectorString(mpiJob, mtype)
+	}
 }
 
 // updateGroupJobConditions updates the conditions of the given mpiJob.
@@ -0,0 +1,186 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// mpiJobCheckpointRequestedReason is the reason of the Suspending
+	// condition while the pods of the GroupJob have time to checkpoint.
+	mpiJobCheckpointRequestedReason = "CheckpointRequested"
+	// mpiJobCheckpointedReason is the reason of the Suspending condition once
+	// a pod acknowledged the checkpoint.
+	mpiJobCheckpointedReason = "Checkpointed"
+	// mpiJobCheckpointTimeoutReason is the reason of the Suspending condition
+	// once the grace period expired without an acknowledgement.
+	mpiJobCheckpointTimeoutReason = "CheckpointTimeout"
+)
+
+// suspendGracePeriod returns how long the pods of mpiJob get to checkpoint
+// when it is suspended.
+func suspendGracePeriod(mpiJob *kubeflow.GroupJob) time.Duration {
+	return time.Duration(ptr.Deref(mpiJob.Spec.RunPolicy.SuspendGracePeriodSeconds, 0)) * time.Second
+}
+
+// waitForCheckpoint asks the running pods of the suspended mpiJob to
+// checkpoint, and returns whether they are kept running until they
+// acknowledge it or the grace period expires. In that case, the rest of the
+// sync is skipped, so that neither the workers nor the launcher are torn down.
+// It also withdraws the request when mpiJob is resumed while its pods were
+// checkpointing.
+func (c *GroupJobController) waitForCheckpoint(mpiJob *kubeflow.GroupJob, launcher *batchv1.Job) (bool, error) {
+	suspending := hasCondition(mpiJob.Status, kubeflow.JobSuspending)
+	if !isGroupJobSuspended(mpiJob) {
+		if !suspending {
+			return false, nil
+		}
+		pods, err := c.runningGroupPods(mpiJob, launcher)
+		if err != nil {
+			return false, err
+		}
+		if err := c.annotatePods(pods, nil); err != nil {
+			return false, err
+		}
+		updateGroupJobConditions(mpiJob, kubeflow.JobSuspending, corev1.ConditionFalse, mpiJobResumedReason, "GroupJob resumed before its pods checkpointed")
+		// The GroupJob is synced again once the status update is observed.
+		return true, c.updateStatusHandler(mpiJob)
+	}
+	gracePeriod := suspendGracePeriod(mpiJob)
+	if gracePeriod == 0 || hasCondition(mpiJob.Status, kubeflow.JobSuspended) {
+		return false, nil
+	}
+	pods, err := c.runningGroupPods(mpiJob, launcher)
+	if err != nil {
+		return false, err
+	}
+
+	if !suspending {
+		// Once the pods are torn down, there is nothing left to checkpoint.
+		if len(pods) == 0 {
+			return false, nil
+		}
+		now := c.clock.Now()
+		if err := c.annotatePods(pods, ptr.To(now.UTC().Format(time.RFC3339))); err != nil {
+			return false, err
+		}
+		msg := fmt.Sprintf("Waiting up to %v for the pods of GroupJob %s/%s to checkpoint", gracePeriod, mpiJob.Namespace, mpiJob.Name)
+		c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobCheckpointRequestedReason, msg)
+		cond := newCondition(kubeflow.JobSuspending, corev1.ConditionTrue, mpiJobCheckpointRequestedReason, msg)
+		cond.LastTransitionTime = metav1.NewTime(now)
+		setCondition(&mpiJob.Status, cond)
+		c.enqueueAfter(mpiJob, gracePeriod)
+		return true, c.updateStatusHandler(mpiJob)
+	}
+
+	if len(pods) == 0 || anyPodCheckpointed(pods) {
+		msg := fmt.Sprintf("The pods of GroupJob %s/%s checkpointed", mpiJob.Namespace, mpiJob.Name)
+		c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobCheckpointedReason, msg)
+		updateGroupJobConditions(mpiJob, kubeflow.JobSuspending, corev1.ConditionFalse, mpiJobCheckpointedReason, msg)
+		return false, nil
+	}
+	since := getCondition(mpiJob.Status, kubeflow.JobSuspending).LastTransitionTime.Time
+	if waited := c.clock.Since(since); waited < gracePeriod {
+		c.enqueueAfter(mpiJob, gracePeriod-waited)
+		return true, nil
+	}
+	msg := fmt.Sprintf("The pods of GroupJob %s/%s didn't checkpoint within %v", mpiJob.Namespace, mpiJob.Name, gracePeriod)
+	klog.Infof("%s", msg)
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobCheckpointTimeoutReason, msg)
+	updateGroupJobConditions(mpiJob, kubeflow.JobSuspending, corev1.ConditionFalse, mpiJobCheckpointTimeoutReason, msg)
+	return false, nil
+}
+
+// runningGroupPods returns the running pods of the active launcher Job and
+// the running workers of mpiJob, leaving out the ones being deleted.
+func (c *GroupJobController) runningGroupPods(mpiJob *kubeflow.GroupJob, launcher *batchv1.Job) ([]*corev1.Pod, error) {
+	var pods []*corev1.Pod
+	if launcher != nil && !isJobFinished(launcher) {
+		launcherPods, err := c.jobPods(launcher)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, launcherPods...)
+	}
+	for _, rType := range workerGroups(mpiJob) {
+		for i := 0; i < int(*mpiJob.Spec.MPIReplicaSpecs[rType].Replicas); i++ {
+			pod, err := c.podLister.Pods(mpiJob.Namespace).Get(groupWorkerName(mpiJob, rType, i))
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if metav1.IsControlledBy(pod, mpiJob) {
+				pods = append(pods, pod)
+			}
+		}
+	}
+	running := pods[:0]
+	for _, pod := range pods {
+		if isPodRunning(pod) && pod.DeletionTimestamp == nil {
+			running = append(running, pod)
+		}
+	}
+	return running, nil
+}
+
+// annotatePods sets the SuspendRequestedAnnotation of the pods to the value,
+// or removes it when the value is nil. Pods already in that state are skipped.
+func (c *GroupJobController) annotatePods(pods []*corev1.Pod, value *string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]*string{kubeflow.SuspendRequestedAnnotation: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if _, ok := pod.Annotations[kubeflow.SuspendRequestedAnnotation]; ok == (value != nil) {
+			continue
+		}
+		_, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("annotating pod %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// anyPodCheckpointed returns whether one of the pods acknowledged the
+// checkpoint.
+func anyPodCheckpointed(pods []*corev1.Pod) bool {
+	for _, pod := range pods {
+		if pod.Annotations[kubeflow.CheckpointedAnnotation] == "true" {
+			return true
+		}
+	}
+	return false
+}
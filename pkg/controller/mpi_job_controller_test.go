@@ -15,19 +15,27 @@
 package controller
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/crypto/ssh"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	kubeinformers "k8s.io/client-go/informers"
@@ -75,7 +83,11 @@ type fixture struct {
 	schedPodGroupLister   []*schedv1alpha1.PodGroup
 	jobLister             []*batchv1.Job
 	podLister             []*corev1.Pod
+	serviceAccountLister  []*corev1.ServiceAccount
+	roleLister            []*rbacv1.Role
+	roleBindingLister     []*rbacv1.RoleBinding
 	priorityClassLister   []*schedulingv1.PriorityClass
+	nodeLister            []*corev1.Node
 	mpiJobLister          []*kubeflow.GroupJob
 
 	// Actions expected to happen on the client.
@@ -87,6 +99,7 @@ type fixture struct {
 	objects     []runtime.Object
 
 	gangSchedulingName string
+	queueConfig        *QueueConfig
 }
 
 func newFixture(t *testing.T, gangSchedulingName string) *fixture {
@@ -176,7 +189,11 @@ func (f *fixture) newController(clock clock.WithTicker) (*GroupJobController, in
 		k8sI.Core().V1().Services(),
 		k8sI.Batch().V1().Jobs(),
 		k8sI.Core().V1().Pods(),
+		k8sI.Core().V1().ServiceAccounts(),
+		k8sI.Rbac().V1().Roles(),
+		k8sI.Rbac().V1().RoleBindings(),
 		k8sI.Scheduling().V1().PriorityClasses(),
+		k8sI.Core().V1().Nodes(),
 		i.Kubeflow().V2beta1().GroupJobs(),
 		clock,
 		metav1.NamespaceAll,
@@ -191,9 +208,14 @@ func (f *fixture) newController(clock clock.WithTicker) (*GroupJobController, in
 	c.serviceSynced = alwaysReady
 	c.secretSynced = alwaysReady
 	c.podSynced = alwaysReady
+	c.serviceAccountSynced = alwaysReady
+	c.roleSynced = alwaysReady
+	c.roleBindingSynced = alwaysReady
+	c.nodeSynced = alwaysReady
 	c.podGroupSynced = alwaysReady
 	c.mpiJobSynced = alwaysReady
 	c.recorder = &record.FakeRecorder{}
+	c.QueueConfig = f.queueConfig
 
 	for _, configMap := range f.configMapLister {
 		err = k8sI.Core().V1().ConfigMaps().Informer().GetIndexer().Add(configMap)
@@ -230,6 +252,27 @@ func (f *fixture) newController(clock clock.WithTicker) (*GroupJobController, in
 		}
 	}
 
+	for _, sa := range f.serviceAccountLister {
+		err = k8sI.Core().V1().ServiceAccounts().Informer().GetIndexer().Add(sa)
+		if err != nil {
+			fmt.Println("Failed to create service account")
+		}
+	}
+
+	for _, role := range f.roleLister {
+		err = k8sI.Rbac().V1().Roles().Informer().GetIndexer().Add(role)
+		if err != nil {
+			fmt.Println("Failed to create role")
+		}
+	}
+
+	for _, rb := range f.roleBindingLister {
+		err = k8sI.Rbac().V1().RoleBindings().Informer().GetIndexer().Add(rb)
+		if err != nil {
+			fmt.Println("Failed to create role binding")
+		}
+	}
+
 	if c.PodGroupCtrl != nil {
 		for _, podGroup := range f.volcanoPodGroupLister {
 			err = c.PodGroupCtrl.PodGroupSharedIndexInformer().GetIndexer().Add(podGroup)
@@ -243,6 +286,8 @@ func (f *fixture) newController(clock clock.WithTicker) (*GroupJobController, in
 				fmt.Println("Failed to create scheduler-plugins pod group")
 			}
 		}
+	}
+	if c.PodGroupCtrl != nil || c.QueueConfig != nil {
 		for _, priorityClass := range f.priorityClassLister {
 			err = k8sI.Scheduling().V1().PriorityClasses().Informer().GetIndexer().Add(priorityClass)
 			if err != nil {
@@ -251,6 +296,13 @@ func (f *fixture) newController(clock clock.WithTicker) (*GroupJobController, in
 		}
 	}
 
+	for _, node := range f.nodeLister {
+		err = k8sI.Core().V1().Nodes().Informer().GetIndexer().Add(node)
+		if err != nil {
+			fmt.Println("Failed to create node")
+		}
+	}
+
 	for _, mpiJob := range f.mpiJobLister {
 		err = i.Kubeflow().V2beta1().GroupJobs().Informer().GetIndexer().Add(mpiJob)
 		if err != nil {
@@ -382,10 +434,18 @@ func filterInformerActions(actions []core.Action) []core.Action {
 				action.Matches("watch", "jobs") ||
 				action.Matches("list", "pods") ||
 				action.Matches("watch", "pods") ||
+				action.Matches("list", "serviceaccounts") ||
+				action.Matches("watch", "serviceaccounts") ||
+				action.Matches("list", "roles") ||
+				action.Matches("watch", "roles") ||
+				action.Matches("list", "rolebindings") ||
+				action.Matches("watch", "rolebindings") ||
 				action.Matches("list", "podgroups") ||
 				action.Matches("watch", "podgroups") ||
 				action.Matches("list", "priorityclasses") ||
 				action.Matches("watch", "priorityclasses") ||
+				action.Matches("list", "nodes") ||
+				action.Matches("watch", "nodes") ||
 				action.Matches("list", "groupjobs") ||
 				action.Matches("watch", "groupjobs")) {
 			continue
@@ -405,6 +465,10 @@ func (f *fixture) expectUpdateJobAction(job *batchv1.Job) {
 	f.kubeActions = append(f.kubeActions, action)
 }
 
+func (f *fixture) expectUpdateConfigMapAction(configMap *corev1.ConfigMap) {
+	f.kubeActions = append(f.kubeActions, core.NewUpdateAction(schema.GroupVersionResource{Resource: "configmaps"}, configMap.Namespace, configMap))
+}
+
 func (f *fixture) expectCreatePodAction(d *corev1.Pod) {
 	f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "pods"}, d.Namespace, d))
 }
@@ -421,6 +485,26 @@ func (f *fixture) expectCreateSecretAction(d *corev1.Secret) {
 	f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "secrets"}, d.Namespace, d))
 }
 
+func (f *fixture) expectUpdateSecretAction(secret *corev1.Secret) {
+	f.kubeActions = append(f.kubeActions, core.NewUpdateAction(schema.GroupVersionResource{Resource: "secrets"}, secret.Namespace, secret))
+}
+
+func (f *fixture) expectCreateServiceAccountAction(sa *corev1.ServiceAccount) {
+	f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "serviceaccounts"}, sa.Namespace, sa))
+}
+
+func (f *fixture) expectCreateRoleAction(role *rbacv1.Role) {
+	f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "roles", Group: "rbac.authorization.k8s.io"}, role.Namespace, role))
+}
+
+func (f *fixture) expectUpdateRoleAction(role *rbacv1.Role) {
+	f.kubeActions = append(f.kubeActions, core.NewUpdateAction(schema.GroupVersionResource{Resource: "roles", Group: "rbac.authorization.k8s.io"}, role.Namespace, role))
+}
+
+func (f *fixture) expectCreateRoleBindingAction(rb *rbacv1.RoleBinding) {
+	f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "rolebindings", Group: "rbac.authorization.k8s.io"}, rb.Namespace, rb))
+}
+
 func (f *fixture) expectNoKubeActions() bool {
 	k8sActions := filterInformerActions(f.kubeClient.Actions())
 	return len(k8sActions) == 0
@@ -462,11 +546,31 @@ func (f *fixture) setUpSecret(secret *corev1.Secret) {
 	f.kubeObjects = append(f.kubeObjects, secret)
 }
 
+func (f *fixture) setUpServiceAccount(sa *corev1.ServiceAccount) {
+	f.serviceAccountLister = append(f.serviceAccountLister, sa)
+	f.kubeObjects = append(f.kubeObjects, sa)
+}
+
+func (f *fixture) setUpRole(role *rbacv1.Role) {
+	f.roleLister = append(f.roleLister, role)
+	f.kubeObjects = append(f.kubeObjects, role)
+}
+
+func (f *fixture) setUpRoleBinding(rb *rbacv1.RoleBinding) {
+	f.roleBindingLister = append(f.roleBindingLister, rb)
+	f.kubeObjects = append(f.kubeObjects, rb)
+}
+
 func (f *fixture) setUpPriorityClass(priorityClass *schedulingv1.PriorityClass) {
 	f.priorityClassLister = append(f.priorityClassLister, priorityClass)
 	f.kubeObjects = append(f.kubeObjects, priorityClass)
 }
 
+func (f *fixture) setUpNode(node *corev1.Node) {
+	f.nodeLister = append(f.nodeLister, node)
+	f.kubeObjects = append(f.kubeObjects, node)
+}
+
 func setUpGroupJobTimestamp(mpiJob *kubeflow.GroupJob, startTime, completionTime *metav1.Time) {
 	if startTime != nil {
 		mpiJob.Status.StartTime = startTime
@@ -486,6 +590,31 @@ func getKey(mpiJob *kubeflow.GroupJob, t *testing.T) string {
 	return key
 }
 
+// restrictedSecurityContextErrors returns the fields of podSpec that don't
+// comply with the restricted Pod Security Standard, among the ones the
+// operator sets.
+func restrictedSecurityContextErrors(podSpec *corev1.PodSpec) []string {
+	var errs []string
+	if sc := podSpec.SecurityContext; sc == nil || !ptr.Deref(sc.RunAsNonRoot, false) {
+		errs = append(errs, "spec.securityContext.runAsNonRoot")
+	}
+	if sc := podSpec.SecurityContext; sc == nil || sc.SeccompProfile == nil || sc.SeccompProfile.Type != corev1.SeccompProfileTypeRuntimeDefault {
+		errs = append(errs, "spec.securityContext.seccompProfile")
+	}
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for _, c := range containers {
+			if c.SecurityContext == nil || ptr.Deref(c.SecurityContext.AllowPrivilegeEscalation, true) {
+				errs = append(errs, c.Name+".securityContext.allowPrivilegeEscalation")
+			}
+			if c.SecurityContext == nil || c.SecurityContext.Capabilities == nil ||
+				!cmp.Equal(c.SecurityContext.Capabilities.Drop, []corev1.Capability{"ALL"}) {
+				errs = append(errs, c.Name+".securityContext.capabilities.drop")
+			}
+		}
+	}
+	return errs
+}
+
 func TestDoNothingWithInvalidKey(t *testing.T) {
 	f := newFixture(t, "")
 	f.run("foo/bar/baz")
@@ -528,13 +657,44 @@ func TestDoNothingWithGroupJobManagedExternally(t *testing.T) {
 }
 
 func TestAllResourcesCreated(t *testing.T) {
-	impls := []kubeflow.MPIImplementation{kubeflow.MPIImplementationOpenMPI, kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH}
-	for _, implementation := range impls {
-		t.Run(string(implementation), func(t *testing.T) {
+	cases := map[string]struct {
+		implementation  kubeflow.MPIImplementation
+		bootstrapMode   kubeflow.BootstrapMode
+		sshRunAsNonRoot bool
+	}{
+		"OpenMPI": {
+			implementation: kubeflow.MPIImplementationOpenMPI,
+		},
+		"Intel": {
+			implementation: kubeflow.MPIImplementationIntel,
+		},
+		"MPICH": {
+			implementation: kubeflow.MPIImplementationMPICH,
+		},
+		"MVAPICH2": {
+			implementation: kubeflow.MPIImplementationMVAPICH2,
+		},
+		"CrayMPICH": {
+			implementation: kubeflow.MPIImplementationCrayMPICH,
+		},
+		"SSH as non-root": {
+			sshRunAsNonRoot: true,
+		},
+		"Exec bootstrap": {
+			bootstrapMode: kubeflow.BootstrapModeExec,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
 			f := newFixture(t, "")
 			now := metav1.Now()
 			mpiJob := newGroupJob("foo", ptr.To[int32](5), &now, nil)
-			mpiJob.Spec.MPIImplementation = implementation
+			mpiJob.Spec.MPIImplementation = tc.implementation
+			mpiJob.Spec.BootstrapMode = tc.bootstrapMode
+			if tc.sshRunAsNonRoot {
+				mpiJob.Spec.SSHRunAsNonRoot = ptr.To(true)
+				mpiJob.Spec.SSHAuthMountPath = "/home/mpiuser/.ssh"
+			}
 			f.setUpGroupJob(mpiJob)
 
 			fmjc := f.newFakeGroupJobController()
@@ -543,12 +703,22 @@ func TestAllResourcesCreated(t *testing.T) {
 			f.expectCreateServiceAction(newJobService(mpiJobCopy))
 			cfgMap := newConfigMap(mpiJobCopy, 5)
 			updateDiscoverHostsInConfigMap(cfgMap, mpiJob, nil)
+			if _, ok := cfgMap.Data[sshdConfigName]; ok != tc.sshRunAsNonRoot {
+				t.Errorf("ConfigMap has %s: %t, want %t", sshdConfigName, ok, tc.sshRunAsNonRoot)
+			}
 			f.expectCreateConfigMapAction(cfgMap)
-			secret, err := newSSHAuthSecret(mpiJobCopy)
-			if err != nil {
-				t.Fatalf("Failed creating secret")
+			if tc.bootstrapMode == kubeflow.BootstrapModeExec {
+				// No SSH Secret is created.
+				f.expectCreateServiceAccountAction(newLauncherServiceAccount(mpiJobCopy))
+				f.expectCreateRoleAction(newLauncherRole(mpiJobCopy))
+				f.expectCreateRoleBindingAction(newLauncherRoleBinding(mpiJobCopy))
+			} else {
+				secret, err := newSSHAuthSecret(mpiJobCopy)
+				if err != nil {
+					t.Fatalf("Failed creating secret")
+				}
+				f.expectCreateSecretAction(secret)
 			}
-			f.expectCreateSecretAction(secret)
 			for i := 0; i < 5; i++ {
 				f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
 			}
@@ -557,7 +727,9 @@ func TestAllResourcesCreated(t *testing.T) {
 			mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/foo is created.")}
 			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 				kubeflow.MPIReplicaTypeLauncher: {},
-				kubeflow.MPIReplicaTypeWorker:   {},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+				},
 			}
 			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
@@ -609,7 +781,9 @@ func TestLauncherSucceeded(t *testing.T) {
 			Succeeded: 1,
 			Failed:    0,
 		},
-		kubeflow.MPIReplicaTypeWorker: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
 	}
 
 	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
@@ -664,7 +838,9 @@ func TestLauncherFailed(t *testing.T) {
 			Succeeded: 0,
 			Failed:    2,
 		},
-		kubeflow.MPIReplicaTypeWorker: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
 	}
 	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
 
@@ -772,324 +948,640 @@ func TestSecretNotControlledByUs(t *testing.T) {
 	f.runExpectError(getKey(mpiJob, t))
 }
 
-func TestShutdownWorker(t *testing.T) {
+func TestSSHAuthSecretKnownHostsFollowScale(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
-	completionTime := metav1.Now()
-
-	var replicas int32 = 8
-	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
-	msg := fmt.Sprintf("GroupJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
 
-	updateGroupJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
 	f.setUpGroupJob(mpiJob)
 
 	fmjc := f.newFakeGroupJobController()
 	mpiJobCopy := mpiJob.DeepCopy()
 	scheme.Scheme.Default(mpiJobCopy)
-	launcher := fmjc.newLauncherJob(mpiJobCopy)
-	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
-		Type:   batchv1.JobComplete,
-		Status: corev1.ConditionTrue,
-	})
-	f.setUpLauncher(launcher)
+	f.setUpService(newJobService(mpiJobCopy))
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
 
-	for i := 0; i < int(replicas); i++ {
-		worker := fmjc.newWorker(mpiJobCopy, i)
-		f.setUpPod(worker)
+	// The Secret was created when the GroupJob had a single worker.
+	scaledJob := mpiJobCopy.DeepCopy()
+	scaledJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](1)
+	secret, err := newSSHAuthSecret(scaledJob)
+	if err != nil {
+		t.Fatalf("Creating SSH auth Secret: %v", err)
 	}
+	f.setUpSecret(secret)
 
+	f.expectUpdateSecretAction(secret)
 	for i := 0; i < int(replicas); i++ {
-		name := fmt.Sprintf("%s-%d", mpiJob.Name+workerSuffix, i)
-		f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, name))
+		f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
 	}
-
+	f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
+	mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/test is created.")}
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {},
 		kubeflow.MPIReplicaTypeWorker: {
-			Active:    0,
-			Succeeded: 0,
-			Failed:    0,
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
 		},
 	}
-	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
 	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
 	f.run(getKey(mpiJob, t))
-}
-
-func TestCreateSuspendedGroupJob(t *testing.T) {
-	impls := []kubeflow.MPIImplementation{kubeflow.MPIImplementationOpenMPI, kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH}
-	for _, implementation := range impls {
-		t.Run(string(implementation), func(t *testing.T) {
-			f := newFixture(t, "")
-
-			// create a suspended job
-			var replicas int32 = 8
-			mpiJob := newGroupJob("test", &replicas, nil, nil)
-			mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
-			mpiJob.Spec.MPIImplementation = implementation
-			f.setUpGroupJob(mpiJob)
-
-			// expect creation of objects
-			scheme.Scheme.Default(mpiJob)
-			f.expectCreateServiceAction(newJobService(mpiJob))
-			cfgMap := newConfigMap(mpiJob, replicas)
-			updateDiscoverHostsInConfigMap(cfgMap, mpiJob, nil)
-			f.expectCreateConfigMapAction(cfgMap)
-			secret, err := newSSHAuthSecret(mpiJob)
-			if err != nil {
-				t.Fatalf("Failed creating secret")
-			}
-			f.expectCreateSecretAction(secret)
-
-			// expect creating of the launcher
-			fmjc := f.newFakeGroupJobController()
-			launcher := fmjc.newLauncherJob(mpiJob)
-			launcher.Spec.Suspend = ptr.To(true)
-			f.expectCreateJobAction(launcher)
-
-			// expect an update to add the conditions
-			mpiJobCopy := mpiJob.DeepCopy()
-			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
-				kubeflow.MPIReplicaTypeLauncher: {},
-				kubeflow.MPIReplicaTypeWorker:   {},
-			}
-			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-			updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-			updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
-			msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
-			updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
-			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
-			f.run(getKey(mpiJob, t))
-		})
+	got, err := f.kubeClient.CoreV1().Secrets(secret.Namespace).Get(context.Background(), secret.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting SSH auth Secret: %v", err)
+	}
+	// Only the known hosts change, the keys are kept.
+	want := secret.DeepCopy()
+	want.Data[sshKnownHosts] = newKnownHosts(mpiJobCopy, secret.Data[sshHostPublicKey])
+	if diff := cmp.Diff(want.Data, got.Data); diff != "" {
+		t.Errorf("Unexpected SSH auth Secret data (-want,+got):\n%s", diff)
 	}
 }
 
-func TestSuspendedRunningGroupJob(t *testing.T) {
+func TestLauncherRoleFollowsScale(t *testing.T) {
 	f := newFixture(t, "")
-
-	// setup a running GroupJob with a launcher
-	var replicas int32 = 8
-	startTime := metav1.Now()
-	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
-	mpiJob.Spec.RunPolicy.Suspend = ptr.To(false)
-	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
-
-	mpiJob.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
-		kubeflow.MPIReplicaTypeLauncher: {
-			Active: 1,
-		},
-		kubeflow.MPIReplicaTypeWorker: {
-			Active: replicas,
-		},
-	}
-
+	now := metav1.Now()
+	mpiJob := newGroupJob("foo", ptr.To[int32](3), &now, nil)
+	mpiJob.Spec.BootstrapMode = kubeflow.BootstrapModeExec
 	f.setUpGroupJob(mpiJob)
 
-	// setup workers
 	fmjc := f.newFakeGroupJobController()
-	var runningPodList []*corev1.Pod
-	for i := 0; i < int(replicas); i++ {
-		worker := fmjc.newWorker(mpiJob, i)
-		worker.Status.Phase = corev1.PodRunning
-		runningPodList = append(runningPodList, worker)
-		f.setUpPod(worker)
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	cfgMap := newConfigMap(mpiJobCopy, 3)
+	updateDiscoverHostsInConfigMap(cfgMap, mpiJobCopy, nil)
+	f.setUpConfigMap(cfgMap)
+	f.setUpServiceAccount(newLauncherServiceAccount(mpiJobCopy))
+	f.setUpRoleBinding(newLauncherRoleBinding(mpiJobCopy))
+	// The Role was created when the GroupJob had a single worker.
+	scaledJob := mpiJobCopy.DeepCopy()
+	scaledJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](1)
+	f.setUpRole(newLauncherRole(scaledJob))
+
+	f.expectUpdateRoleAction(newLauncherRole(mpiJobCopy))
+	for i := 0; i < 3; i++ {
+		f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
 	}
+	f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
 
-	// setup objects
-	scheme.Scheme.Default(mpiJob)
-	f.setUpService(newJobService(mpiJob))
-
-	cfgMap := newConfigMap(mpiJob, replicas)
-	updateDiscoverHostsInConfigMap(cfgMap, mpiJob, runningPodList)
-	f.setUpConfigMap(cfgMap)
-	secret, err := newSSHAuthSecret(mpiJob)
-	if err != nil {
-		t.Fatalf("Failed creating secret")
+	mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/foo is created.")}
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
 	}
-	f.setUpSecret(secret)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
-	// setup launcher and its pod
-	launcher := fmjc.newLauncherJob(mpiJob)
-	launcher.Spec.Suspend = ptr.To(false)
-	launcherPod := mockJobPod(launcher)
-	launcherPod.Status.Phase = corev1.PodRunning
-	f.setUpLauncher(launcher)
-	f.setUpPod(launcherPod)
+	f.run(getKey(mpiJob, t))
+}
 
-	// transition the GroupJob into suspended state
+func TestSSHAuthSecretAlgorithmChange(t *testing.T) {
+	f := newFixture(t, "")
+
+	var replicas int32 = 1
+	mpiJob := newGroupJob("test", &replicas, nil, nil)
+	mpiJob.Spec.SSHKeyAlgorithm = kubeflow.SSHKeyAlgorithmEd25519
 	mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+	f.setUpGroupJob(mpiJob)
 
-	// expect moving the launcher pod into suspended state
-	launcherCopy := launcher.DeepCopy()
-	launcherCopy.Spec.Suspend = ptr.To(true)
-	f.expectUpdateJobAction(launcherCopy)
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
 
-	// expect removal of the pods
-	for i := 0; i < int(replicas); i++ {
-		name := fmt.Sprintf("%s-%d", mpiJob.Name+workerSuffix, i)
-		f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, name))
+	// The Secret was generated with the default algorithm.
+	oldJob := mpiJobCopy.DeepCopy()
+	oldJob.Spec.SSHKeyAlgorithm = kubeflow.SSHKeyAlgorithmECDSA
+	secret, err := newSSHAuthSecret(oldJob)
+	if err != nil {
+		t.Fatalf("Creating SSH auth Secret: %v", err)
 	}
+	f.setUpSecret(secret)
 
-	// expect MPI job status update to add the suspend condition
-	mpiJobCopy := mpiJob.DeepCopy()
+	f.expectUpdateSecretAction(secret)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Spec.Suspend = ptr.To(true)
+	f.expectCreateJobAction(launcher)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/test is created.")
 	updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
-	msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJobCopy.Namespace, mpiJobCopy.Name)
-	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, "GroupJob default/test is suspended.")
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
-		// the launcher pod remains active. In live system it gets deleted by
-		// the launcher's Job controller.
-		kubeflow.MPIReplicaTypeLauncher: {
-			Active: 1,
+		kubeflow.MPIReplicaTypeLauncher: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
 		},
-		kubeflow.MPIReplicaTypeWorker: {},
 	}
 	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
 	f.run(getKey(mpiJob, t))
+
+	got, err := f.kubeClient.CoreV1().Secrets(secret.Namespace).Get(context.Background(), secret.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting SSH auth Secret: %v", err)
+	}
+	for _, key := range []string{sshPublicKey, sshHostPublicKey} {
+		if !hasSSHKeyAlgorithm(got.Data[key], kubeflow.SSHKeyAlgorithmEd25519) {
+			t.Errorf("Got %s %q, want an Ed25519 key", key, got.Data[key])
+		}
+	}
 }
 
-func TestResumeGroupJob(t *testing.T) {
-	fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+func TestReferencedSSHAuthSecret(t *testing.T) {
 	f := newFixture(t, "")
-
-	// create a suspended job
-	var replicas int32 = 8
 	startTime := metav1.Now()
+
+	var replicas int32 = 2
 	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
-	mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
-	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-	updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
-	msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
-	mpiJob.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+	mpiJob.Spec.SSHAuthSecretName = "team-ssh"
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
+	f.setUpSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-ssh",
+			Namespace: mpiJob.Namespace,
+		},
+		Data: map[string][]byte{
+			corev1.SSHAuthPrivateKey: []byte("private"),
+			sshPublicKey:             []byte("public"),
+		},
+	})
+
+	// The referenced Secret is used as is and no Secret is generated.
+	fmjc := f.newFakeGroupJobController()
+	worker := fmjc.newWorker(mpiJobCopy, 0)
+	wantVolumes := []corev1.Volume{{
+		Name: sshAuthVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				DefaultMode: ptr.To[int32](0600),
+				SecretName:  "team-ssh",
+				Items:       sshClientVolumeItems,
+			},
+		},
+	}}
+	if diff := cmp.Diff(wantVolumes, worker.Spec.Volumes); diff != "" {
+		t.Errorf("Unexpected worker volumes (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"/usr/sbin/sshd", "-De"}, worker.Spec.Containers[0].Command); diff != "" {
+		t.Errorf("Unexpected worker command (-want,+got):\n%s", diff)
+	}
+	for i := 0; i < int(replicas); i++ {
+		f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
+	}
+	f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
+	mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/test is created.")}
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 		kubeflow.MPIReplicaTypeLauncher: {},
-		kubeflow.MPIReplicaTypeWorker:   {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
 	}
-	f.setUpGroupJob(mpiJob)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
-	// expect creation of objects
-	scheme.Scheme.Default(mpiJob)
-	f.expectCreateServiceAction(newJobService(mpiJob))
-	cfgMap := newConfigMap(mpiJob, replicas)
-	updateDiscoverHostsInConfigMap(cfgMap, mpiJob, nil)
-	f.setUpConfigMap(cfgMap)
-	secret, err := newSSHAuthSecret(mpiJob)
+	f.run(getKey(mpiJob, t))
+}
+
+func TestInvalidReferencedSSHAuthSecret(t *testing.T) {
+	testCases := map[string]*corev1.Secret{
+		"not found": nil,
+		"missing keys": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "team-ssh",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Data: map[string][]byte{
+				corev1.SSHAuthPrivateKey: []byte("private"),
+			},
+		},
+	}
+	for name, secret := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, "")
+			startTime := metav1.Now()
+
+			var replicas int32 = 2
+			mpiJob := newGroupJob("test", &replicas, &startTime, nil)
+			mpiJob.Spec.SSHAuthSecretName = "team-ssh"
+			f.setUpGroupJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			f.setUpService(newJobService(mpiJobCopy))
+			configMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+			f.setUpConfigMap(configMap)
+			if secret != nil {
+				f.setUpSecret(secret)
+			}
+
+			// No Pods are created until the Secret is fixed.
+			f.runExpectError(getKey(mpiJob, t))
+		})
+	}
+}
+
+func TestNewSSHKey(t *testing.T) {
+	algorithms := []kubeflow.SSHKeyAlgorithm{
+		"",
+		kubeflow.SSHKeyAlgorithmECDSA,
+		kubeflow.SSHKeyAlgorithmEd25519,
+		kubeflow.SSHKeyAlgorithmRSA,
+	}
+	for _, algorithm := range algorithms {
+		t.Run(string(algorithm), func(t *testing.T) {
+			privatePEM, publicKey, err := newSSHKey(algorithm)
+			if err != nil {
+				t.Fatalf("Generating SSH key: %v", err)
+			}
+			signer, err := ssh.ParsePrivateKey(privatePEM)
+			if err != nil {
+				t.Fatalf("Parsing private SSH key: %v", err)
+			}
+			if diff := cmp.Diff(string(ssh.MarshalAuthorizedKey(signer.PublicKey())), string(publicKey)); diff != "" {
+				t.Errorf("Public SSH key doesn't match the private key (-want,+got):\n%s", diff)
+			}
+			if !hasSSHKeyAlgorithm(publicKey, algorithm) {
+				t.Errorf("Got public SSH key %q, want algorithm %q", publicKey, algorithm)
+			}
+		})
+	}
+	_, publicKey, err := newSSHKey(kubeflow.SSHKeyAlgorithmEd25519)
 	if err != nil {
-		t.Fatalf("Failed creating secret")
+		t.Fatalf("Generating SSH key: %v", err)
 	}
-	f.setUpSecret(secret)
+	if hasSSHKeyAlgorithm(publicKey, kubeflow.SSHKeyAlgorithmECDSA) {
+		t.Errorf("Ed25519 public key %q reported as ECDSA", publicKey)
+	}
+}
 
-	// expect creating of the launcher
-	fmjc := f.newFakeGroupJobController()
-	launcher := fmjc.newLauncherJob(mpiJob)
-	launcher.Spec.Suspend = ptr.To(true)
-	f.setUpLauncher(launcher)
+func TestNewKnownHosts(t *testing.T) {
+	hostKey := []byte("ecdsa-sha2-nistp521 AAAA\n")
+	testCases := map[string]struct {
+		job  *kubeflow.GroupJob
+		want string
+	}{
+		"workers": {
+			job: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "tenant-a"},
+				Spec: kubeflow.GroupJobSpec{
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeWorker: {Replicas: ptr.To[int32](2)},
+						"gpu":                         {Replicas: ptr.To[int32](1)},
+					},
+				},
+			},
+			want: "foo-worker-0.foo.tenant-a.svc,[foo-worker-0.foo.tenant-a.svc]:*,foo-worker-1.foo.tenant-a.svc,[foo-worker-1.foo.tenant-a.svc]:*,foo-gpu-0.foo.tenant-a.svc,[foo-gpu-0.foo.tenant-a.svc]:* ecdsa-sha2-nistp521 AAAA\n",
+		},
+		"launcher as worker": {
+			job: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "tenant-a"},
+				Spec: kubeflow.GroupJobSpec{
+					RunLauncherAsWorker: ptr.To(true),
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeWorker: {Replicas: ptr.To[int32](1)},
+					},
+				},
+			},
+			want: "foo-launcher.foo.tenant-a.svc,[foo-launcher.foo.tenant-a.svc]:*,foo-worker-0.foo.tenant-a.svc,[foo-worker-0.foo.tenant-a.svc]:* ecdsa-sha2-nistp521 AAAA\n",
+		},
+		"no hosts": {
+			job: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "tenant-a"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, string(newKnownHosts(tc.job, hostKey))); diff != "" {
+				t.Errorf("Unexpected known_hosts (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
 
-	// move the timer by a second so that the StartTime is updated after resume
-	fakeClock.Sleep(time.Second)
+func TestNewSSHDConfig(t *testing.T) {
+	cases := map[string]struct {
+		port       *int32
+		secretName string
+		want       string
+	}{
+		"generated Secret": {
+			port: ptr.To[int32](2022),
+			want: `Port 2022
+HostKey /home/mpiuser/.ssh/ssh_host_key
+AuthorizedKeysFile /home/mpiuser/.ssh/authorized_keys
+PidFile none
+StrictModes no
+UsePAM no
+`,
+		},
+		"referenced Secret": {
+			secretName: "shared-keys",
+			want: `Port 2222
+HostKey /home/mpiuser/.ssh/id_rsa
+AuthorizedKeysFile /home/mpiuser/.ssh/authorized_keys
+PidFile none
+StrictModes no
+UsePAM no
+`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newGroupJob("foo", ptr.To[int32](1), nil, nil)
+			mpiJob.Spec.SSHRunAsNonRoot = ptr.To(true)
+			mpiJob.Spec.SSHAuthMountPath = "/home/mpiuser/.ssh"
+			mpiJob.Spec.SSHPort = tc.port
+			mpiJob.Spec.SSHAuthSecretName = tc.secretName
+			if diff := cmp.Diff(tc.want, newSSHDConfig(mpiJob)); diff != "" {
+				t.Errorf("Unexpected sshd_config (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
 
-	// resume the GroupJob
-	mpiJob.Spec.RunPolicy.Suspend = ptr.To(false)
+func TestSSHBootstrapArgs(t *testing.T) {
+	cases := map[string]struct {
+		nonRoot    bool
+		secretName string
+		want       string
+	}{
+		"generated Secret": {
+			want: "-o ConnectionAttempts=10",
+		},
+		"generated Secret, non-root": {
+			nonRoot: true,
+			want:    "-o ConnectionAttempts=10 -p 2222 -i /home/mpiuser/.ssh/id_rsa -o UserKnownHostsFile=/home/mpiuser/.ssh/known_hosts",
+		},
+		"referenced Secret": {
+			secretName: "shared-keys",
+			want:       "-o ConnectionAttempts=10 -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null",
+		},
+		"referenced Secret, non-root": {
+			nonRoot:    true,
+			secretName: "shared-keys",
+			want:       "-o ConnectionAttempts=10 -p 2222 -i /home/mpiuser/.ssh/id_rsa -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newGroupJob("foo", ptr.To[int32](1), nil, nil)
+			mpiJob.Spec.SSHRunAsNonRoot = ptr.To(tc.nonRoot)
+			mpiJob.Spec.SSHAuthMountPath = "/home/mpiuser/.ssh"
+			mpiJob.Spec.SSHAuthSecretName = tc.secretName
+			scheme.Scheme.Default(mpiJob)
+			launcher := (&GroupJobController{}).newLauncherPodTemplate(mpiJob)
+			var got string
+			for _, env := range launcher.Spec.Containers[0].Env {
+				if env.Name == "OMPI_MCA_plm_rsh_args" {
+					got = env.Value
+				}
+			}
+			if got != tc.want {
+				t.Errorf("Got SSH args %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
 
-	// expect creation of the pods
-	for i := 0; i < int(replicas); i++ {
-		worker := fmjc.newWorker(mpiJob, i)
-		f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, worker))
+func TestNewWorkerSSHCustomMountPath(t *testing.T) {
+	mpiJob := newGroupJob("foo", ptr.To[int32](1), nil, nil)
+	mpiJob.Spec.SSHAuthMountPath = "/home/mpiuser/.ssh"
+	scheme.Scheme.Default(mpiJob)
+	c := &GroupJobController{}
+
+	worker := c.newWorker(mpiJob, 0)
+	wantCommand := []string{"/usr/sbin/sshd", "-De", "-h", "/home/mpiuser/.ssh/ssh_host_key"}
+	if diff := cmp.Diff(wantCommand, worker.Spec.Containers[0].Command); diff != "" {
+		t.Errorf("Unexpected worker command (-want,+got):\n%s", diff)
+	}
+	// Unlike the other files, the private keys are only readable by root,
+	// which runs sshd.
+	wantItems := []corev1.KeyToPath{
+		{Key: corev1.SSHAuthPrivateKey, Path: sshPrivateKeyFile, Mode: ptr.To[int32](0600)},
+		{Key: sshPublicKey, Path: sshPublicKeyFile},
+		{Key: sshPublicKey, Path: sshAuthorizedKeysFile},
+		{Key: sshHostPrivateKey, Path: sshHostKeyFile, Mode: ptr.To[int32](0600)},
+		{Key: sshKnownHosts, Path: sshKnownHostsFile},
+	}
+	wantVolumes := []corev1.Volume{{
+		Name: sshAuthVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: "foo-ssh",
+				Items:      wantItems,
+			},
+		},
+	}}
+	if diff := cmp.Diff(wantVolumes, worker.Spec.Volumes); diff != "" {
+		t.Errorf("Unexpected worker volumes (-want,+got):\n%s", diff)
 	}
+}
 
-	// expect the launcher update to resume it
-	launcherCopy := launcher.DeepCopy()
-	launcherCopy.Spec.Suspend = ptr.To(false)
-	f.expectUpdateJobAction(launcherCopy)
+func TestNewLauncherAndWorkerSSHNonRoot(t *testing.T) {
+	mpiJob := newGroupJob("foo", ptr.To[int32](1), nil, nil)
+	mpiJob.Spec.SSHRunAsNonRoot = ptr.To(true)
+	mpiJob.Spec.SSHAuthMountPath = "/home/mpiuser/.ssh"
+	scheme.Scheme.Default(mpiJob)
+	c := &GroupJobController{}
 
-	// expect an update to add the conditions
-	mpiJobCopy := mpiJob.DeepCopy()
-	mpiJobCopy.Status.StartTime = &metav1.Time{Time: fakeClock.Now()}
-	updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionFalse, "GroupJobResumed", "GroupJob resumed")
-	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+	worker := c.newWorker(mpiJob, 0)
+	wantCommand := []string{"/usr/sbin/sshd", "-De", "-f", "/etc/mpi/sshd_config"}
+	if diff := cmp.Diff(wantCommand, worker.Spec.Containers[0].Command); diff != "" {
+		t.Errorf("Unexpected worker command (-want,+got):\n%s", diff)
+	}
+	wantVolumes := []corev1.Volume{
+		{
+			Name: sshAuthVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					DefaultMode: ptr.To[int32](0444),
+					SecretName:  "foo-ssh",
+					Items:       sshVolumeItems,
+				},
+			},
+		},
+		{
+			Name: configVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "foo-config"},
+					Items:                []corev1.KeyToPath{sshdConfigVolumeItem},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantVolumes, worker.Spec.Volumes); diff != "" {
+		t.Errorf("Unexpected worker volumes (-want,+got):\n%s", diff)
+	}
+	if errs := restrictedSecurityContextErrors(&worker.Spec); len(errs) != 0 {
+		t.Errorf("Worker doesn't comply with the restricted profile: %v", errs)
+	}
 
-	f.runWithClock(getKey(mpiJob, t), fakeClock)
+	launcher := c.newLauncherPodTemplate(mpiJob)
+	wantArgs := "-o ConnectionAttempts=10 -p 2222 -i /home/mpiuser/.ssh/id_rsa -o UserKnownHostsFile=/home/mpiuser/.ssh/known_hosts"
+	var gotArgs string
+	for _, env := range launcher.Spec.Containers[0].Env {
+		if env.Name == "OMPI_MCA_plm_rsh_args" {
+			gotArgs = env.Value
+		}
+	}
+	if gotArgs != wantArgs {
+		t.Errorf("Got SSH args %q, want %q", gotArgs, wantArgs)
+	}
+	if errs := restrictedSecurityContextErrors(&launcher.Spec); len(errs) != 0 {
+		t.Errorf("Launcher doesn't comply with the restricted profile: %v", errs)
+	}
+
+	// The security context set by the user is kept.
+	workerSpec := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec
+	workerSpec.SecurityContext = &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](1000)}
+	workerSpec.Containers[0].SecurityContext = &corev1.SecurityContext{
+		Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}, Drop: []corev1.Capability{"NET_RAW"}},
+	}
+	worker = c.newWorker(mpiJob, 0)
+	wantPodSC := &corev1.PodSecurityContext{
+		RunAsUser:      ptr.To[int64](1000),
+		RunAsNonRoot:   ptr.To(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	if diff := cmp.Diff(wantPodSC, worker.Spec.SecurityContext); diff != "" {
+		t.Errorf("Unexpected worker Pod security context (-want,+got):\n%s", diff)
+	}
+	wantSC := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities:             &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}, Drop: []corev1.Capability{"NET_RAW"}},
+	}
+	if diff := cmp.Diff(wantSC, worker.Spec.Containers[0].SecurityContext); diff != "" {
+		t.Errorf("Unexpected worker container security context (-want,+got):\n%s", diff)
+	}
 }
 
-func TestWorkerNotControlledByUs(t *testing.T) {
-	f := newFixture(t, "")
-	startTime := metav1.Now()
-	completionTime := metav1.Now()
+func TestNewLauncherRole(t *testing.T) {
+	mpiJob := newGroupJob("foo", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.BootstrapMode = kubeflow.BootstrapModeExec
+	mpiJob.Spec.MPIReplicaSpecs["gpu"] = &kubeflow.ReplicaSpec{
+		Replicas: ptr.To[int32](1),
+		Template: mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template,
+	}
+	role := newLauncherRole(mpiJob)
+	wantNames := []string{"foo-worker-0", "foo-worker-1", "foo-gpu-0"}
+	if len(role.Rules) != 2 {
+		t.Fatalf("Got %d rules, want 2", len(role.Rules))
+	}
+	for _, rule := range role.Rules {
+		if diff := cmp.Diff(wantNames, rule.ResourceNames); diff != "" {
+			t.Errorf("Unexpected resource names for %v (-want,+got):\n%s", rule.Resources, diff)
+		}
+	}
 
-	var replicas int32 = 8
-	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
-	f.setUpGroupJob(mpiJob)
+	// Without workers, the Role must not grant access to every Pod.
+	mpiJob = newGroupJob("foo", nil, nil, nil)
+	mpiJob.Spec.BootstrapMode = kubeflow.BootstrapModeExec
+	if role := newLauncherRole(mpiJob); len(role.Rules) != 0 {
+		t.Errorf("Got rules %v for a GroupJob without workers, want none", role.Rules)
+	}
+}
 
-	mpiJobCopy := mpiJob.DeepCopy()
-	scheme.Scheme.Default(mpiJobCopy)
-	configMap := newConfigMap(mpiJobCopy, replicas)
-	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
-	f.setUpConfigMap(configMap)
-	f.setUpService(newJobService(mpiJobCopy))
-	secret, err := newSSHAuthSecret(mpiJobCopy)
-	if err != nil {
-		t.Fatalf("Creating SSH auth secret: %v", err)
+func TestNewLauncherAndWorkerExecBootstrap(t *testing.T) {
+	mpiJob := newGroupJob("foo", ptr.To[int32](1), nil, nil)
+	mpiJob.Spec.BootstrapMode = kubeflow.BootstrapModeExec
+	scheme.Scheme.Default(mpiJob)
+	c := &GroupJobController{}
+
+	worker := c.newWorker(mpiJob, 0)
+	if diff := cmp.Diff(execWorkerCommand, worker.Spec.Containers[0].Command); diff != "" {
+		t.Errorf("Unexpected worker command (-want,+got):\n%s", diff)
+	}
+	if len(worker.Spec.Volumes) != 0 {
+		t.Errorf("Got worker volumes %v, want none", worker.Spec.Volumes)
 	}
-	f.setUpSecret(secret)
-	fmjc := f.newFakeGroupJobController()
 
-	for i := 0; i < int(replicas); i++ {
-		worker := fmjc.newWorker(mpiJobCopy, i)
-		worker.OwnerReferences = nil
-		f.setUpPod(worker)
+	launcher := c.newLauncherPodTemplate(mpiJob)
+	if got, want := launcher.Spec.ServiceAccountName, "foo-launcher"; got != want {
+		t.Errorf("Got launcher ServiceAccount %q, want %q", got, want)
+	}
+	wantEnv := joinEnvVars(
+		launcherEnvVars,
+		corev1.EnvVar{Name: "OMPI_MCA_orte_keep_fqdn_hostnames", Value: "true"},
+		corev1.EnvVar{Name: "OMPI_MCA_orte_default_hostfile", Value: "/etc/mpi/hostfile"},
+		corev1.EnvVar{Name: "OMPI_MCA_plm_rsh_agent", Value: "/etc/mpi/kubexec.sh"},
+		corev1.EnvVar{Name: "OMPI_MCA_orte_set_default_slots", Value: "1"},
+		nvidiaDisableEnvVars)
+	if diff := cmp.Diff(wantEnv, launcher.Spec.Containers[0].Env); diff != "" {
+		t.Errorf("Unexpected launcher environment (-want,+got):\n%s", diff)
+	}
+	wantVolumes := []corev1.Volume{
+		{
+			Name: configVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "foo-config"},
+					Items:                append(configVolumeItems, execAgentVolumeItem),
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantVolumes, launcher.Spec.Volumes); diff != "" {
+		t.Errorf("Unexpected launcher volumes (-want,+got):\n%s", diff)
 	}
 
-	f.runExpectError(getKey(mpiJob, t))
+	// A ServiceAccount set by the user is kept.
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.ServiceAccountName = "custom"
+	if got := c.newLauncherPodTemplate(mpiJob).Spec.ServiceAccountName; got != "custom" {
+		t.Errorf("Got launcher ServiceAccount %q, want custom", got)
+	}
 }
 
-func TestLauncherActiveWorkerNotReady(t *testing.T) {
+func TestShutdownWorker(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
 	completionTime := metav1.Now()
 
 	var replicas int32 = 8
 	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
+
+	updateGroupJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
 	f.setUpGroupJob(mpiJob)
 
+	fmjc := f.newFakeGroupJobController()
 	mpiJobCopy := mpiJob.DeepCopy()
 	scheme.Scheme.Default(mpiJobCopy)
-	configMap := newConfigMap(mpiJobCopy, replicas)
-	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
-	f.setUpConfigMap(configMap)
-	f.setUpService(newJobService(mpiJobCopy))
-	secret, err := newSSHAuthSecret(mpiJobCopy)
-	if err != nil {
-		t.Fatalf("Creating SSH auth secret: %v", err)
-	}
-	f.setUpSecret(secret)
-
-	fmjc := f.newFakeGroupJobController()
 	launcher := fmjc.newLauncherJob(mpiJobCopy)
-	launcherPod := mockJobPod(launcher)
-	launcherPod.Status.Phase = corev1.PodRunning
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:   batchv1.JobComplete,
+		Status: corev1.ConditionTrue,
+	})
 	f.setUpLauncher(launcher)
-	f.setUpPod(launcherPod)
 
 	for i := 0; i < int(replicas); i++ {
 		worker := fmjc.newWorker(mpiJobCopy, i)
-		worker.Status.Phase = corev1.PodPending
 		f.setUpPod(worker)
 	}
-	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+
+	for i := 0; i < int(replicas); i++ {
+		name := fmt.Sprintf("%s-%d", mpiJob.Name+workerSuffix, i)
+		f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, name))
+	}
+
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
-		kubeflow.MPIReplicaTypeLauncher: {
-			Active:    1,
-			Succeeded: 0,
-			Failed:    0,
-		},
 		kubeflow.MPIReplicaTypeWorker: {
+			Selector:  workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
 			Active:    0,
 			Succeeded: 0,
 			Failed:    0,
@@ -1101,194 +1593,1800 @@ func TestLauncherActiveWorkerNotReady(t *testing.T) {
 	f.run(getKey(mpiJob, t))
 }
 
-func TestLauncherActiveWorkerReady(t *testing.T) {
-	f := newFixture(t, "")
-	startTime := metav1.Now()
-	completionTime := metav1.Now()
-
-	var replicas int32 = 8
-	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+func TestCreateSuspendedGroupJob(t *testing.T) {
+	impls := []kubeflow.MPIImplementation{kubeflow.MPIImplementationOpenMPI, kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH, kubeflow.MPIImplementationMVAPICH2, kubeflow.MPIImplementationCrayMPICH}
+	for _, implementation := range impls {
+		t.Run(string(implementation), func(t *testing.T) {
+			f := newFixture(t, "")
+
+			// create a suspended job
+			var replicas int32 = 8
+			mpiJob := newGroupJob("test", &replicas, nil, nil)
+			mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+			mpiJob.Spec.MPIImplementation = implementation
+			f.setUpGroupJob(mpiJob)
+
+			// expect creation of objects
+			scheme.Scheme.Default(mpiJob)
+			f.expectCreateServiceAction(newJobService(mpiJob))
+			cfgMap := newConfigMap(mpiJob, replicas)
+			updateDiscoverHostsInConfigMap(cfgMap, mpiJob, nil)
+			f.expectCreateConfigMapAction(cfgMap)
+			secret, err := newSSHAuthSecret(mpiJob)
+			if err != nil {
+				t.Fatalf("Failed creating secret")
+			}
+			f.expectCreateSecretAction(secret)
+
+			// expect creating of the launcher
+			fmjc := f.newFakeGroupJobController()
+			launcher := fmjc.newLauncherJob(mpiJob)
+			launcher.Spec.Suspend = ptr.To(true)
+			f.expectCreateJobAction(launcher)
+
+			// expect an update to add the conditions
+			mpiJobCopy := mpiJob.DeepCopy()
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+				},
+			}
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
+			msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.run(getKey(mpiJob, t))
+		})
+	}
+}
+
+func TestSuspendedRunningGroupJob(t *testing.T) {
+	f := newFixture(t, "")
+
+	// setup a running GroupJob with a launcher
+	var replicas int32 = 8
+	startTime := metav1.Now()
+	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
+	mpiJob.Spec.RunPolicy.Suspend = ptr.To(false)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+
+	mpiJob.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:   replicas,
+		},
+	}
+
 	f.setUpGroupJob(mpiJob)
 
-	mpiJobCopy := mpiJob.DeepCopy()
-	scheme.Scheme.Default(mpiJobCopy)
-	f.setUpService(newJobService(mpiJobCopy))
-	secret, err := newSSHAuthSecret(mpiJobCopy)
+	// setup workers
+	fmjc := f.newFakeGroupJobController()
+	var runningPodList []*corev1.Pod
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJob, i)
+		worker.Status.Phase = corev1.PodRunning
+		runningPodList = append(runningPodList, worker)
+		f.setUpPod(worker)
+	}
+
+	// setup objects
+	scheme.Scheme.Default(mpiJob)
+	f.setUpService(newJobService(mpiJob))
+
+	cfgMap := newConfigMap(mpiJob, replicas)
+	updateDiscoverHostsInConfigMap(cfgMap, mpiJob, runningPodList)
+	f.setUpConfigMap(cfgMap)
+	secret, err := newSSHAuthSecret(mpiJob)
 	if err != nil {
-		t.Fatalf("Creating SSH auth secret: %v", err)
+		t.Fatalf("Failed creating secret")
 	}
 	f.setUpSecret(secret)
 
-	fmjc := f.newFakeGroupJobController()
-	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	// setup launcher and its pod
+	launcher := fmjc.newLauncherJob(mpiJob)
+	launcher.Spec.Suspend = ptr.To(false)
 	launcherPod := mockJobPod(launcher)
 	launcherPod.Status.Phase = corev1.PodRunning
 	f.setUpLauncher(launcher)
 	f.setUpPod(launcherPod)
 
-	var runningPodList []*corev1.Pod
+	// transition the GroupJob into suspended state
+	mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+
+	// expect moving the launcher pod into suspended state
+	launcherCopy := launcher.DeepCopy()
+	launcherCopy.Spec.Suspend = ptr.To(true)
+	f.expectUpdateJobAction(launcherCopy)
+
+	// expect removal of the pods
 	for i := 0; i < int(replicas); i++ {
-		worker := fmjc.newWorker(mpiJobCopy, i)
-		worker.Status.Phase = corev1.PodRunning
-		runningPodList = append(runningPodList, worker)
-		f.setUpPod(worker)
+		name := fmt.Sprintf("%s-%d", mpiJob.Name+workerSuffix, i)
+		f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, name))
 	}
 
-	configMap := newConfigMap(mpiJobCopy, replicas)
-	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
-	f.setUpConfigMap(configMap)
-
+	// expect MPI job status update to add the suspend condition
+	mpiJobCopy := mpiJob.DeepCopy()
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
+	msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJobCopy.Namespace, mpiJobCopy.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		// the launcher pod remains active. In live system it gets deleted by
+		// the launcher's Job controller.
 		kubeflow.MPIReplicaTypeLauncher: {
-			Active:    1,
-			Succeeded: 0,
-			Failed:    0,
+			Active: 1,
 		},
 		kubeflow.MPIReplicaTypeWorker: {
-			Active:    8,
-			Succeeded: 0,
-			Failed:    0,
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
 		},
 	}
-	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
-	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
 	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
 	f.run(getKey(mpiJob, t))
 }
 
-func TestWorkerReady(t *testing.T) {
+func TestResumeGroupJob(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
 	f := newFixture(t, "")
-	startTime := metav1.Now()
-	completionTime := metav1.Now()
 
-	var replicas int32 = 16
-	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	// create a suspended job
+	var replicas int32 = 8
+	startTime := metav1.Now()
+	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
+	mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
+	msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+	mpiJob.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
+	}
 	f.setUpGroupJob(mpiJob)
 
-	mpiJobCopy := mpiJob.DeepCopy()
-	scheme.Scheme.Default(mpiJobCopy)
-	f.setUpService(newJobService(mpiJobCopy))
-	secret, err := newSSHAuthSecret(mpiJobCopy)
+	// expect creation of objects
+	scheme.Scheme.Default(mpiJob)
+	f.expectCreateServiceAction(newJobService(mpiJob))
+	cfgMap := newConfigMap(mpiJob, replicas)
+	updateDiscoverHostsInConfigMap(cfgMap, mpiJob, nil)
+	f.setUpConfigMap(cfgMap)
+	secret, err := newSSHAuthSecret(mpiJob)
 	if err != nil {
-		t.Fatalf("Creating SSH auth secret: %v", err)
+		t.Fatalf("Failed creating secret")
 	}
 	f.setUpSecret(secret)
 
+	// expect creating of the launcher
 	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJob)
+	launcher.Spec.Suspend = ptr.To(true)
+	f.setUpLauncher(launcher)
 
-	var runningPodList []*corev1.Pod
-	for i := 0; i < 16; i++ {
-		worker := fmjc.newWorker(mpiJobCopy, i)
-		worker.Status.Phase = corev1.PodRunning
-		runningPodList = append(runningPodList, worker)
-		f.setUpPod(worker)
-	}
-
-	configMap := newConfigMap(mpiJobCopy, replicas)
-	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
-	f.setUpConfigMap(configMap)
+	// move the timer by a second so that the StartTime is updated after resume
+	fakeClock.Sleep(time.Second)
 
-	expLauncher := fmjc.newLauncherJob(mpiJobCopy)
-	f.expectCreateJobAction(expLauncher)
+	// resume the GroupJob
+	mpiJob.Spec.RunPolicy.Suspend = ptr.To(false)
 
-	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
-		kubeflow.MPIReplicaTypeLauncher: {
-			Active:    0,
-			Succeeded: 0,
-			Failed:    0,
-		},
-		kubeflow.MPIReplicaTypeWorker: {
-			Active:    16,
-			Succeeded: 0,
-			Failed:    0,
-		},
+	// expect creation of the pods
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJob, i)
+		f.kubeActions = append(f.kubeActions, core.NewCreateAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, worker))
 	}
-	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+
+	// expect the launcher update to resume it
+	launcherCopy := launcher.DeepCopy()
+	launcherCopy.Spec.Suspend = ptr.To(false)
+	f.expectUpdateJobAction(launcherCopy)
+
+	// expect an update to add the conditions
+	mpiJobCopy := mpiJob.DeepCopy()
+	mpiJobCopy.Status.StartTime = &metav1.Time{Time: fakeClock.Now()}
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionFalse, "GroupJobResumed", "GroupJob resumed")
 	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
 
+	f.runWithClock(getKey(mpiJob, t), fakeClock)
+}
+
+func TestSuspendedGroupJobRecreatesChangedLauncher(t *testing.T) {
+	f := newFixture(t, "")
+
+	var replicas int32 = 8
+	startTime := metav1.Now()
+	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
+	mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+	f.setUpGroupJob(mpiJob)
+
+	// setup the launcher created from the previous template
+	oldJob := mpiJob.DeepCopy()
+	scheme.Scheme.Default(oldJob)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(oldJob)
+	f.setUpLauncher(launcher)
+
+	// change the launcher template while suspended
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Image = "baz"
+
+	// expect the launcher to be deleted, so that it is recreated
+	f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "jobs", Group: "batch"}, launcher.Namespace, launcher.Name))
+
 	f.run(getKey(mpiJob, t))
 }
 
-func TestNewLauncherAndWorker(t *testing.T) {
-	cases := map[string]struct {
-		job          kubeflow.GroupJob
-		workerIndex  int
-		wantLauncher batchv1.Job
-		wantWorker   corev1.Pod
+func TestRunningGroupJobIgnoresChangedTemplates(t *testing.T) {
+	testCases := map[string]struct {
+		reported bool
 	}{
-		"defaults": {
-			job: kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "foo",
-					Namespace: "bar",
-				},
-				Spec: kubeflow.GroupJobSpec{
-					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
-						kubeflow.MPIReplicaTypeLauncher: {
-							Template: corev1.PodTemplateSpec{
-								Spec: corev1.PodSpec{
+		"first sync reports the change": {},
+		"change already reported": {
+			reported: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, "")
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			var replicas int32 = 8
+			mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+			f.setUpGroupJob(mpiJob)
+
+			// setup the launcher and workers created from the previous templates
+			oldJob := mpiJob.DeepCopy()
+			scheme.Scheme.Default(oldJob)
+			f.setUpService(newJobService(oldJob))
+			secret, err := newSSHAuthSecret(oldJob)
+			if err != nil {
+				t.Fatalf("Creating SSH auth secret: %v", err)
+			}
+			f.setUpSecret(secret)
+
+			// change the templates while running
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Image = "baz"
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "baz"
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+
+			fmjc := f.newFakeGroupJobController()
+			launcherHash := fmjc.replicaSpecHash(mpiJobCopy, kubeflow.MPIReplicaTypeLauncher)
+			workerHash := fmjc.replicaSpecHash(mpiJobCopy, kubeflow.MPIReplicaTypeWorker)
+
+			launcher := fmjc.newLauncherJob(oldJob)
+			if tc.reported {
+				metav1.SetMetaDataAnnotation(&launcher.ObjectMeta, kubeflow.SpecChangeIgnoredAnnotation, launcherHash)
+			}
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Status.Phase = corev1.PodRunning
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+
+			var runningPodList []*corev1.Pod
+			for i := 0; i < int(replicas); i++ {
+				worker := fmjc.newWorker(oldJob, i)
+				worker.Status.Phase = corev1.PodRunning
+				if tc.reported {
+					metav1.SetMetaDataAnnotation(&worker.ObjectMeta, kubeflow.SpecChangeIgnoredAnnotation, workerHash)
+				}
+				runningPodList = append(runningPodList, worker)
+				f.setUpPod(worker)
+			}
+
+			configMap := newConfigMap(oldJob, replicas)
+			updateDiscoverHostsInConfigMap(configMap, oldJob, runningPodList)
+			f.setUpConfigMap(configMap)
+
+			// expect no pods or Jobs to be recreated, only annotated once
+			if !tc.reported {
+				reportedLauncher := launcher.DeepCopy()
+				metav1.SetMetaDataAnnotation(&reportedLauncher.ObjectMeta, kubeflow.SpecChangeIgnoredAnnotation, launcherHash)
+				f.expectUpdateJobAction(reportedLauncher)
+				for _, worker := range runningPodList {
+					f.kubeActions = append(f.kubeActions, core.NewPatchAction(schema.GroupVersionResource{Resource: "pods"}, worker.Namespace, worker.Name, types.MergePatchType, specChangeReportedPatch(workerHash)))
+				}
+			}
+
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Active: 1,
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+					Active:   8,
+				},
+			}
+			setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.run(getKey(mpiJob, t))
+		})
+	}
+}
+
+func TestReplicaSpecHash(t *testing.T) {
+	var replicas int32 = 2
+	job := newGroupJob("test", &replicas, nil, nil)
+	scheme.Scheme.Default(job)
+	ctrl := &GroupJobController{}
+	launcherHash := ctrl.replicaSpecHash(job, kubeflow.MPIReplicaTypeLauncher)
+	workerHash := ctrl.replicaSpecHash(job, kubeflow.MPIReplicaTypeWorker)
+	if launcherHash == workerHash {
+		t.Errorf("Launcher and worker share hash %q", launcherHash)
+	}
+
+	scaled := job.DeepCopy()
+	scaled.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](4)
+	if got := ctrl.replicaSpecHash(scaled, kubeflow.MPIReplicaTypeWorker); got != workerHash {
+		t.Errorf("Worker hash changed after scaling: got %q, want %q", got, workerHash)
+	}
+
+	implementation := job.DeepCopy()
+	implementation.Spec.MPIImplementation = kubeflow.MPIImplementationIntel
+	if got := ctrl.replicaSpecHash(implementation, kubeflow.MPIReplicaTypeLauncher); got == launcherHash {
+		t.Error("Launcher hash didn't change with the MPI implementation")
+	}
+	if got := ctrl.replicaSpecHash(implementation, kubeflow.MPIReplicaTypeWorker); got != workerHash {
+		t.Errorf("Worker hash changed with the MPI implementation: got %q, want %q", got, workerHash)
+	}
+
+	template := job.DeepCopy()
+	template.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "baz"
+	if got := ctrl.replicaSpecHash(template, kubeflow.MPIReplicaTypeWorker); got == workerHash {
+		t.Error("Worker hash didn't change with the template")
+	}
+	if got := ctrl.replicaSpecHash(template, kubeflow.MPIReplicaTypeLauncher); got != launcherHash {
+		t.Errorf("Launcher hash changed with the worker template: got %q, want %q", got, launcherHash)
+	}
+
+	nonRoot := job.DeepCopy()
+	nonRoot.Spec.SSHRunAsNonRoot = ptr.To(true)
+	nonRoot.Spec.SSHPort = ptr.To[int32](2222)
+	if got := ctrl.replicaSpecHash(nonRoot, kubeflow.MPIReplicaTypeWorker); got == workerHash {
+		t.Error("Running sshd as non-root didn't change the hash of the workers")
+	}
+
+	avoided := job.DeepCopy()
+	avoided.Spec.RunPolicy.NodeFailureLimit = ptr.To[int32](1)
+	avoided.Status.NodeFailures = []kubeflow.NodeFailure{{NodeName: "node-a", Failures: 1}}
+	if got := ctrl.replicaSpecHash(avoided, kubeflow.MPIReplicaTypeWorker); got != workerHash {
+		t.Errorf("Worker hash changed with the avoided nodes: got %q, want %q", got, workerHash)
+	}
+}
+
+func TestWorkerNotControlledByUs(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 8
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+	fmjc := f.newFakeGroupJobController()
+
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.OwnerReferences = nil
+		f.setUpPod(worker)
+	}
+
+	f.runExpectError(getKey(mpiJob, t))
+}
+
+func TestLauncherActiveWorkerNotReady(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 8
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodPending
+		f.setUpPod(worker)
+	}
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active:    1,
+			Succeeded: 0,
+			Failed:    0,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector:  workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:    0,
+			Succeeded: 0,
+			Failed:    0,
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestLauncherActiveWorkerReady(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 8
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	var runningPodList []*corev1.Pod
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		runningPodList = append(runningPodList, worker)
+		f.setUpPod(worker)
+	}
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active:    1,
+			Succeeded: 0,
+			Failed:    0,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector:  workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:    8,
+			Succeeded: 0,
+			Failed:    0,
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestWorkerRetryableExitCode(t *testing.T) {
+	cases := map[string]struct {
+		backoffLimit *int32
+		// failedWorkers fail at the same time; 1 if unset.
+		failedWorkers int
+		// restartCount and lastRestartAgo, relative to the failure of the
+		// workers, describe the restarts counted before the sync.
+		restartCount   int32
+		lastRestartAgo *time.Duration
+		wantCount      int32
+		wantDelete     bool
+		wantFailed     bool
+	}{
+		"restart is counted": {
+			wantCount: 1,
+		},
+		"counted restart deletes the worker": {
+			restartCount:   1,
+			lastRestartAgo: ptr.To(-time.Second),
+			wantCount:      1,
+			wantDelete:     true,
+		},
+		"restarts of workers failing together are counted": {
+			failedWorkers: 2,
+			wantCount:     2,
+		},
+		"counted restarts delete the workers failing together": {
+			failedWorkers:  2,
+			restartCount:   2,
+			lastRestartAgo: ptr.To(-time.Second),
+			wantCount:      2,
+			wantDelete:     true,
+		},
+		"second of the workers failing together reaches the backoff limit": {
+			backoffLimit:  ptr.To[int32](1),
+			failedWorkers: 2,
+			wantCount:     1,
+			wantFailed:    true,
+		},
+		"restart after a previous one is counted": {
+			restartCount:   1,
+			lastRestartAgo: ptr.To(time.Minute),
+			wantCount:      2,
+		},
+		"backoff limit reached": {
+			backoffLimit:   ptr.To[int32](1),
+			restartCount:   1,
+			lastRestartAgo: ptr.To(time.Minute),
+			wantCount:      1,
+			wantFailed:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			failureTime := metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+			failed := max(tc.failedWorkers, 1)
+			f := newFixture(t, "")
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			var replicas int32 = 4
+			mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].RestartPolicy = kubeflow.RestartPolicyExitCode
+			mpiJob.Spec.RunPolicy.BackoffLimit = tc.backoffLimit
+			mpiJob.Status.RestartCount = tc.restartCount
+			if tc.lastRestartAgo != nil {
+				mpiJob.Status.LastRestartTime = ptr.To(metav1.NewTime(failureTime.Add(-*tc.lastRestartAgo)))
+			}
+			f.setUpGroupJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			f.setUpService(newJobService(mpiJobCopy))
+			secret, err := newSSHAuthSecret(mpiJobCopy)
+			if err != nil {
+				t.Fatalf("Creating SSH auth secret: %v", err)
+			}
+			f.setUpSecret(secret)
+
+			fmjc := f.newFakeGroupJobController()
+			launcher := fmjc.newLauncherJob(mpiJobCopy)
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Status.Phase = corev1.PodRunning
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+
+			var runningPodList []*corev1.Pod
+			for i := 0; i < int(replicas); i++ {
+				worker := fmjc.newWorker(mpiJobCopy, i)
+				if i < failed {
+					worker.Status.Phase = corev1.PodFailed
+					worker.Status.ContainerStatuses = []corev1.ContainerStatus{{
+						Name: "foo",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, FinishedAt: failureTime},
+						},
+					}}
+				} else {
+					worker.Status.Phase = corev1.PodRunning
+					runningPodList = append(runningPodList, worker)
+				}
+				f.setUpPod(worker)
+			}
+
+			configMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+			f.setUpConfigMap(configMap)
+
+			if tc.wantDelete {
+				for i := 0; i < failed; i++ {
+					f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, workerName(mpiJob, i)))
+				}
+			}
+
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Active: 1,
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector:      workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+					Active:        replicas - int32(failed),
+					Failed:        int32(failed),
+					FailedByCause: map[kubeflow.FailureCause]int32{kubeflow.FailureCauseError: int32(failed)},
+				},
+			}
+			setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+			mpiJobCopy.Status.RestartCount = tc.wantCount
+			if tc.wantCount != tc.restartCount {
+				mpiJobCopy.Status.LastRestartTime = ptr.To(metav1.NewTime(fakeClock.Now()))
+			}
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			if tc.wantFailed {
+				cause := fmt.Sprintf("Restarting worker pod %s: container %q terminated with retryable exit code %d", workerName(mpiJob, failed-1), "foo", 137)
+				msg = fmt.Sprintf("GroupJob %s/%s has reached the backoff limit of %d restarts: %s", mpiJob.Namespace, mpiJob.Name, *tc.backoffLimit, cause)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobBackoffLimitExceededReason, msg)
+			} else {
+				msg = fmt.Sprintf("%d/4 workers are restarting after a retryable exit code", failed)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobRestarting, corev1.ConditionTrue, mpiJobRestartingReason, msg)
+			}
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.runWithClock(getKey(mpiJob, t), fakeClock)
+		})
+	}
+}
+
+func TestWorkerPermanentExitCode(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 4
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].RestartPolicy = kubeflow.RestartPolicyExitCode
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].RetryableExitCodes = []int32{137}
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	var runningPodList []*corev1.Pod
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		if i == 2 {
+			worker.Status.Phase = corev1.PodFailed
+			worker.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name: "foo",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 139},
+				},
+			}}
+		} else {
+			worker.Status.Phase = corev1.PodRunning
+			runningPodList = append(runningPodList, worker)
+		}
+		f.setUpPod(worker)
+	}
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector:      workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:        3,
+			Failed:        1,
+			FailedByCause: map[kubeflow.FailureCause]int32{kubeflow.FailureCauseError: 1},
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("worker pod %s/%s: container %q terminated with permanent exit code %d", mpiJob.Namespace, workerName(mpiJob, 2), "foo", 139)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobPermanentExitCodeReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestFailedGroupJobSuspendsActiveLauncher(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].RestartPolicy = kubeflow.RestartPolicyExitCode
+	mpiJob.Spec.RunPolicy.CleanPodPolicy = ptr.To(kubeflow.CleanPodPolicyNone)
+	msg := fmt.Sprintf("worker pod %s/%s: container %q terminated with permanent exit code %d", mpiJob.Namespace, workerName(mpiJob, 0), "foo", 139)
+	updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobPermanentExitCodeReason, msg)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	f.setUpLauncher(launcher)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpPod(launcherPod)
+
+	// The launcher Job is kept, but its pods are stopped.
+	launcherCopy := launcher.DeepCopy()
+	launcherCopy.Spec.Suspend = ptr.To(true)
+	f.expectUpdateJobAction(launcherCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestGroupRestartWorkerEvicted(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.RestartMode = ptr.To(kubeflow.RestartModeGroup)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	if got := launcher.Annotations[kubeflow.RestartCountAnnotation]; got != "0" {
+		t.Errorf("Unexpected restart count annotation on launcher: %q", got)
+	}
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		if i == 1 {
+			worker.Status.Phase = corev1.PodFailed
+			worker.Status.Reason = "Evicted"
+		}
+		f.setUpPod(worker)
+	}
+
+	// The restart is persisted before anything is deleted.
+	mpiJobCopy.Status.RestartCount = 1
+	msg = fmt.Sprintf("GroupJob %s/%s is restarting (restart 1): worker pod %s was evicted", mpiJob.Namespace, mpiJob.Name, workerName(mpiJob, 1))
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRestarting, corev1.ConditionTrue, mpiJobRestartingReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestGroupRestartWaitsForStaleWorkers(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.RestartMode = ptr.To(kubeflow.RestartModeGroup)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	f.setUpGroupJob(mpiJob)
+
+	// The launcher and workers are created before the restart is recorded.
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	f.setUpLauncher(launcher)
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodFailed
+		f.setUpPod(worker)
+	}
+	mpiJob.Status.RestartCount = 1
+
+	f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "jobs"}, mpiJob.Namespace, launcher.Name))
+	for i := 0; i < int(replicas); i++ {
+		f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, workerName(mpiJob, i)))
+	}
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestGroupRestartBackoffLimitExceeded(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.RestartMode = ptr.To(kubeflow.RestartModeGroup)
+	mpiJob.Spec.RunPolicy.BackoffLimit = ptr.To[int32](1)
+	mpiJob.Status.RestartCount = 1
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:   batchv1.JobFailed,
+		Status: corev1.ConditionTrue,
+		Reason: batchv1.JobReasonBackoffLimitExceeded,
+	})
+	f.setUpLauncher(launcher)
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		f.setUpPod(worker)
+	}
+
+	msg = fmt.Sprintf("GroupJob %s/%s has reached the backoff limit of 1 restarts: launcher Job %s failed", mpiJob.Namespace, mpiJob.Name, launcher.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobBackoffLimitExceededReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestElasticWorkersMinReplicasReady(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 4
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.LauncherCreationPolicy = kubeflow.LauncherCreationPolicyWaitForWorkersReady
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].MinReplicas = ptr.To[int32](2)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].MaxReplicas = ptr.To[int32](4)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	var runningPodList []*corev1.Pod
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodPending
+		if i < 2 {
+			worker.Status.Phase = corev1.PodRunning
+			worker.Status.Conditions = []corev1.PodCondition{{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}}
+			runningPodList = append(runningPodList, worker)
+		}
+		f.setUpPod(worker)
+	}
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateHostfileInConfigMap(configMap, mpiJobCopy, runningPodList)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+	wantHostfile := fmt.Sprintf("%s.test.default.svc slots=1\n%s.test.default.svc slots=1\n", workerName(mpiJob, 0), workerName(mpiJob, 1))
+	if diff := cmp.Diff(wantHostfile, configMap.Data[hostfileName]); diff != "" {
+		t.Errorf("Unexpected hostfile (-want,+got):\n%s", diff)
+	}
+
+	f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:   2,
+		},
+	}
+	mpiJobCopy.Status.ElasticReplicas = ptr.To[int32](2)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestElasticWorkerEvicted(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 3
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].MinReplicas = ptr.To[int32](2)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	var runningPodList []*corev1.Pod
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		if i == 2 {
+			worker.Status.Phase = corev1.PodFailed
+			worker.Status.Reason = "Evicted"
+		} else {
+			runningPodList = append(runningPodList, worker)
+		}
+		f.setUpPod(worker)
+	}
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateHostfileInConfigMap(configMap, mpiJobCopy, runningPodList)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+
+	f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, workerName(mpiJob, 2)))
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector:      workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:        2,
+			Failed:        1,
+			FailedByCause: map[kubeflow.FailureCause]int32{kubeflow.FailureCauseEvicted: 1},
+		},
+	}
+	mpiJobCopy.Status.ElasticReplicas = ptr.To[int32](2)
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestScaleUpWorkers(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 4
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	// The GroupJob was scaled up from 2 workers.
+	var runningPodList []*corev1.Pod
+	for i := 0; i < 2; i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		runningPodList = append(runningPodList, worker)
+		f.setUpPod(worker)
+	}
+	configMap := newConfigMap(mpiJobCopy, 2)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+
+	wantConfigMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(wantConfigMap, mpiJobCopy, runningPodList)
+	f.expectUpdateConfigMapAction(wantConfigMap)
+	for i := 2; i < int(replicas); i++ {
+		f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
+	}
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:   2,
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestWorkerGroupsRunning(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	highMem := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].DeepCopy()
+	highMem.Replicas = ptr.To[int32](1)
+	highMem.SlotsPerWorker = ptr.To[int32](4)
+	mpiJob.Spec.MPIReplicaSpecs["HighMem"] = highMem
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	var runningPodList []*corev1.Pod
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		runningPodList = append(runningPodList, worker)
+		f.setUpPod(worker)
+	}
+	worker := fmjc.newGroupWorker(mpiJobCopy, "HighMem", 0)
+	if worker.Name != "test-highmem-0" {
+		t.Errorf("Unexpected name of the HighMem worker: %s", worker.Name)
+	}
+	// The indexes of the HighMem group follow the ones of the Worker group.
+	if index := worker.Labels[kubeflow.ReplicaIndexLabel]; index != "2" {
+		t.Errorf("Unexpected replica index of the HighMem worker: %s", index)
+	}
+	worker.Status.Phase = corev1.PodRunning
+	runningPodList = append(runningPodList, worker)
+	f.setUpPod(worker)
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+	wantHostfile := "test-worker-0.test.default.svc slots=1\ntest-worker-1.test.default.svc slots=1\ntest-highmem-0.test.default.svc slots=4\n"
+	if diff := cmp.Diff(wantHostfile, configMap.Data[hostfileName]); diff != "" {
+		t.Errorf("Unexpected hostfile (-want,+got):\n%s", diff)
+	}
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:   2,
+		},
+		"HighMem": {
+			Selector: workerSelectorString(mpiJob, "HighMem"),
+			Active:   1,
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestWorkerGroupReplicaIndexes(t *testing.T) {
+	cases := map[string]struct {
+		runLauncherAsWorker bool
+		wantIndexes         []string
+	}{
+		"workers only": {
+			wantIndexes: []string{"0", "1", "2", "3", "4"},
+		},
+		"launcher as worker": {
+			runLauncherAsWorker: true,
+			wantIndexes:         []string{"1", "2", "3", "4", "5"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newGroupJob("test", ptr.To[int32](2), nil, nil)
+			mpiJob.Spec.RunLauncherAsWorker = ptr.To(tc.runLauncherAsWorker)
+			highMem := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].DeepCopy()
+			highMem.Replicas = ptr.To[int32](3)
+			mpiJob.Spec.MPIReplicaSpecs["HighMem"] = highMem
+			scheme.Scheme.Default(mpiJob)
+
+			ctrl := &GroupJobController{}
+			var indexes []string
+			for _, rType := range workerGroups(mpiJob) {
+				for i := 0; i < int(*mpiJob.Spec.MPIReplicaSpecs[rType].Replicas); i++ {
+					indexes = append(indexes, ctrl.newGroupWorker(mpiJob, rType, i).Labels[kubeflow.ReplicaIndexLabel])
+				}
+			}
+			if diff := cmp.Diff(tc.wantIndexes, indexes); diff != "" {
+				t.Errorf("Unexpected replica indexes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkerSelectorString(t *testing.T) {
+	mpiJob := newGroupJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.MPIReplicaSpecs["HighMem"] = mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].DeepCopy()
+	legacy := defaultLabels(mpiJob.Name, worker)
+	labelsOf := func(rType kubeflow.MPIReplicaType) labels.Set {
+		set := defaultLabels(mpiJob.Name, worker)
+		set[kubeflow.ReplicaTypeLabel] = workerGroupName(rType)
+		return set
+	}
+	tests := map[string]struct {
+		rType     kubeflow.MPIReplicaType
+		pod       labels.Set
+		wantMatch bool
+	}{
+		"worker": {
+			rType:     kubeflow.MPIReplicaTypeWorker,
+			pod:       labelsOf(kubeflow.MPIReplicaTypeWorker),
+			wantMatch: true,
+		},
+		"worker without replica type": {
+			rType:     kubeflow.MPIReplicaTypeWorker,
+			pod:       legacy,
+			wantMatch: true,
+		},
+		"other group for worker": {
+			rType: kubeflow.MPIReplicaTypeWorker,
+			pod:   labelsOf("HighMem"),
+		},
+		"other group": {
+			rType:     "HighMem",
+			pod:       labelsOf("HighMem"),
+			wantMatch: true,
+		},
+		"worker for other group": {
+			rType: "HighMem",
+			pod:   labelsOf(kubeflow.MPIReplicaTypeWorker),
+		},
+		"worker without replica type for other group": {
+			rType: "HighMem",
+			pod:   legacy,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			selector, err := labels.Parse(workerSelectorString(mpiJob, tc.rType))
+			if err != nil {
+				t.Fatalf("Parsing selector: %v", err)
+			}
+			if got := selector.Matches(tc.pod); got != tc.wantMatch {
+				t.Errorf("Selector %s matches %v: %t, want %t", selector, tc.pod, got, tc.wantMatch)
+			}
+		})
+	}
+}
+
+func TestScaleDownWorkerGroup(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 1
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodRunning
+	f.setUpLauncher(launcher)
+	f.setUpPod(launcherPod)
+
+	var runningPodList []*corev1.Pod
+	worker := fmjc.newWorker(mpiJobCopy, 0)
+	worker.Status.Phase = corev1.PodRunning
+	runningPodList = append(runningPodList, worker)
+	f.setUpPod(worker)
+
+	// The HighMem worker group was removed from the GroupJob.
+	removedJob := mpiJobCopy.DeepCopy()
+	removedJob.Spec.MPIReplicaSpecs["HighMem"] = mpiJobCopy.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].DeepCopy()
+	removedWorker := fmjc.newGroupWorker(removedJob, "HighMem", 0)
+	removedWorker.Status.Phase = corev1.PodRunning
+	f.setUpPod(removedWorker)
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, append(runningPodList, removedWorker))
+	f.setUpConfigMap(configMap)
+
+	f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, removedWorker.Name))
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:   1,
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	msg = fmt.Sprintf("GroupJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestNewExitCodePodFailurePolicy(t *testing.T) {
+	cases := map[string]struct {
+		spec       kubeflow.ReplicaSpec
+		wantValues []int32
+	}{
+		"default retryable exit codes": {
+			spec: kubeflow.ReplicaSpec{
+				RestartPolicy: kubeflow.RestartPolicyExitCode,
+			},
+			wantValues: func() []int32 {
+				values := []int32{0}
+				for code := int32(128); code <= 255; code++ {
+					values = append(values, code)
+				}
+				return values
+			}(),
+		},
+		"custom retryable exit codes": {
+			spec: kubeflow.ReplicaSpec{
+				RestartPolicy:      kubeflow.RestartPolicyExitCode,
+				RetryableExitCodes: []int32{143, 1, 137},
+			},
+			wantValues: []int32{0, 1, 137, 143},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			want := &batchv1.PodFailurePolicy{
+				Rules: []batchv1.PodFailurePolicyRule{{
+					Action: batchv1.PodFailurePolicyActionFailJob,
+					OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+						Operator: batchv1.PodFailurePolicyOnExitCodesOpNotIn,
+						Values:   tc.wantValues,
+					},
+				}},
+			}
+			got := newExitCodePodFailurePolicy(&tc.spec)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Unexpected podFailurePolicy (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkerReady(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 16
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeGroupJobController()
+
+	var runningPodList []*corev1.Pod
+	for i := 0; i < 16; i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		runningPodList = append(runningPodList, worker)
+		f.setUpPod(worker)
+	}
+
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+	f.setUpConfigMap(configMap)
+
+	expLauncher := fmjc.newLauncherJob(mpiJobCopy)
+	f.expectCreateJobAction(expLauncher)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Active:    0,
+			Succeeded: 0,
+			Failed:    0,
+		},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector:  workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+			Active:    16,
+			Succeeded: 0,
+			Failed:    0,
+		},
+	}
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestNewLauncherAndWorker(t *testing.T) {
+	cases := map[string]struct {
+		job          kubeflow.GroupJob
+		workerIndex  int
+		wantLauncher batchv1.Job
+		wantWorker   corev1.Pod
+	}{
+		"defaults": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantLauncher: batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-launcher",
+					Namespace: "bar",
+					Labels: map[string]string{
+						"app": "foo",
+					},
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+								kubeflow.JobNameLabel:      "foo",
+								kubeflow.JobRoleLabel:      "launcher",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Hostname:      "foo-launcher",
+							Subdomain:     "foo",
+							RestartPolicy: corev1.RestartPolicyOnFailure,
+							Containers: []corev1.Container{
+								{
+									Env: joinEnvVars(
+										launcherEnvVars,
+										ompiEnvVars,
+										corev1.EnvVar{Name: openMPISlotsEnv, Value: "1"},
+										nvidiaDisableEnvVars),
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "ssh-auth", MountPath: "/root/.ssh"},
+										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "ssh-auth",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											DefaultMode: ptr.To[int32](0600),
+											SecretName:  "foo-ssh",
+											Items:       sshVolumeItems,
+										},
+									},
+								},
+								{
+									Name: "mpi-job-config",
+									VolumeSource: corev1.VolumeSource{
+										ConfigMap: &corev1.ConfigMapVolumeSource{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "foo-config",
+											},
+											Items: configVolumeItems,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantWorker: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-worker-0",
+					Namespace: "bar",
+					Labels: map[string]string{
+						kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+						kubeflow.JobNameLabel:      "foo",
+						kubeflow.JobRoleLabel:      "worker",
+						kubeflow.ReplicaTypeLabel:  "worker",
+						kubeflow.ReplicaIndexLabel: "0",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Hostname:  "foo-worker-0",
+					Subdomain: "foo",
+					DNSConfig: &corev1.PodDNSConfig{
+						Searches: []string{"foo.bar.svc.cluster.local"},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Command: []string{"/usr/sbin/sshd", "-De", "-h", "/root/.ssh/ssh_host_key"},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "ssh-auth", MountPath: "/root/.ssh"},
+							},
+							Env: workerEnvVars,
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "ssh-auth",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									DefaultMode: ptr.To[int32](0600),
+									SecretName:  "foo-ssh",
+									Items:       sshVolumeItems,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"launcher-as-worker": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					RunLauncherAsWorker: ptr.To(true),
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
 									Containers: []corev1.Container{{}},
 								},
 							},
 						},
+					},
+				},
+			},
+			wantLauncher: batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-launcher",
+					Namespace: "bar",
+					Labels: map[string]string{
+						"app": "foo",
+					},
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+								kubeflow.JobNameLabel:      "foo",
+								kubeflow.JobRoleLabel:      "launcher",
+								kubeflow.ReplicaIndexLabel: "0",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Hostname:      "foo-launcher",
+							Subdomain:     "foo",
+							RestartPolicy: corev1.RestartPolicyOnFailure,
+							Containers: []corev1.Container{
+								{
+									Env: joinEnvVars(
+										launcherEnvVars,
+										ompiEnvVars,
+										corev1.EnvVar{Name: openMPISlotsEnv, Value: "1"},
+									),
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "ssh-auth", MountPath: "/root/.ssh"},
+										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "ssh-auth",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											DefaultMode: ptr.To[int32](0600),
+											SecretName:  "foo-ssh",
+											Items:       sshVolumeItems,
+										},
+									},
+								},
+								{
+									Name: "mpi-job-config",
+									VolumeSource: corev1.VolumeSource{
+										ConfigMap: &corev1.ConfigMapVolumeSource{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "foo-config",
+											},
+											Items: configVolumeItems,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantWorker: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-worker-0",
+					Namespace: "bar",
+					Labels: map[string]string{
+						kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+						kubeflow.JobNameLabel:      "foo",
+						kubeflow.JobRoleLabel:      "worker",
+						kubeflow.ReplicaTypeLabel:  "worker",
+						kubeflow.ReplicaIndexLabel: "1",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Hostname:  "foo-worker-0",
+					Subdomain: "foo",
+					DNSConfig: &corev1.PodDNSConfig{
+						Searches: []string{"foo.bar.svc.cluster.local"},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Command: []string{"/usr/sbin/sshd", "-De", "-h", "/root/.ssh/ssh_host_key"},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "ssh-auth", MountPath: "/root/.ssh"},
+							},
+							Env: workerEnvVars,
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "ssh-auth",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									DefaultMode: ptr.To[int32](0600),
+									SecretName:  "foo-ssh",
+									Items:       sshVolumeItems,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"overrides": {
+			job: kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bar",
+					Namespace: "foo",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					SlotsPerWorker:    ptr.To[int32](5),
+					MPIImplementation: kubeflow.MPIImplementationIntel,
+					RunPolicy: kubeflow.RunPolicy{
+						TTLSecondsAfterFinished: ptr.To[int32](1),
+						ActiveDeadlineSeconds:   ptr.To[int64](2),
+						BackoffLimit:            ptr.To[int32](3),
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							RestartPolicy: kubeflow.RestartPolicyOnFailure,
+							Template: corev1.PodTemplateSpec{
+								ObjectMeta: metav1.ObjectMeta{
+									Labels: map[string]string{"foo": "bar"},
+								},
+								Spec: corev1.PodSpec{
+									HostNetwork: true,
+									Containers: []corev1.Container{
+										{
+											Env: []corev1.EnvVar{
+												{Name: "FOO", Value: "bar"},
+											},
+											SecurityContext: &corev1.SecurityContext{
+												RunAsUser: ptr.To[int64](1000),
+											},
+											VolumeMounts: []corev1.VolumeMount{
+												{Name: "fool-vol", MountPath: "/mnt/foo"},
+											},
+										},
+										{},
+									},
+									Volumes: []corev1.Volume{
+										{Name: "foo-vol"},
+									},
+								},
+							},
+						},
 						kubeflow.MPIReplicaTypeWorker: {
 							Template: corev1.PodTemplateSpec{
 								Spec: corev1.PodSpec{
-									Containers: []corev1.Container{{}},
+									HostNetwork: true,
+									Containers: []corev1.Container{
+										{
+											Command: []string{"/entrypoint.sh"},
+											Env: []corev1.EnvVar{
+												{Name: "FOO", Value: "bar"},
+											},
+										},
+									},
 								},
 							},
 						},
 					},
 				},
 			},
+			workerIndex: 12,
 			wantLauncher: batchv1.Job{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "foo-launcher",
-					Namespace: "bar",
+					Name:      "bar-launcher",
+					Namespace: "foo",
 					Labels: map[string]string{
-						"app": "foo",
+						"app": "bar",
 					},
 				},
 				Spec: batchv1.JobSpec{
+					TTLSecondsAfterFinished: ptr.To[int32](1),
+					ActiveDeadlineSeconds:   ptr.To[int64](2),
+					BackoffLimit:            ptr.To[int32](3),
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Labels: map[string]string{
+								"foo":                      "bar",
 								kubeflow.OperatorNameLabel: kubeflow.OperatorName,
-								kubeflow.JobNameLabel:      "foo",
+								kubeflow.JobNameLabel:      "bar",
 								kubeflow.JobRoleLabel:      "launcher",
 							},
 						},
 						Spec: corev1.PodSpec{
-							Hostname:      "foo-launcher",
-							Subdomain:     "foo",
+							HostNetwork:   true,
+							DNSPolicy:     corev1.DNSClusterFirstWithHostNet,
+							Hostname:      "bar-launcher",
+							Subdomain:     "bar",
 							RestartPolicy: corev1.RestartPolicyOnFailure,
 							Containers: []corev1.Container{
 								{
+									SecurityContext: &corev1.SecurityContext{
+										RunAsUser: ptr.To[int64](1000),
+									},
 									Env: joinEnvVars(
+										corev1.EnvVar{Name: "FOO", Value: "bar"},
 										launcherEnvVars,
-										ompiEnvVars,
-										corev1.EnvVar{Name: openMPISlotsEnv, Value: "1"},
+										intelEnvVars,
+										corev1.EnvVar{Name: "I_MPI_PERHOST", Value: "5"},
 										nvidiaDisableEnvVars),
 									VolumeMounts: []corev1.VolumeMount{
-										{Name: "ssh-auth", MountPath: "/root/.ssh"},
+										{Name: "fool-vol", MountPath: "/mnt/foo"},
+										{Name: "ssh-auth", MountPath: "/home/mpiuser/.ssh"},
 										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
 									},
 								},
+								{},
 							},
 							Volumes: []corev1.Volume{
+								{Name: "foo-vol"},
 								{
 									Name: "ssh-auth",
 									VolumeSource: corev1.VolumeSource{
 										Secret: &corev1.SecretVolumeSource{
-											DefaultMode: ptr.To[int32](0600),
-											SecretName:  "foo-ssh",
-											Items:       sshVolumeItems,
+											SecretName: "bar-ssh",
+											Items:      sshVolumeItems,
 										},
 									},
 								},
@@ -1297,7 +3395,7 @@ func TestNewLauncherAndWorker(t *testing.T) {
 									VolumeSource: corev1.VolumeSource{
 										ConfigMap: &corev1.ConfigMapVolumeSource{
 											LocalObjectReference: corev1.LocalObjectReference{
-												Name: "foo-config",
+												Name: "bar-config",
 											},
 											Items: configVolumeItems,
 										},
@@ -1310,29 +3408,32 @@ func TestNewLauncherAndWorker(t *testing.T) {
 			},
 			wantWorker: corev1.Pod{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "foo-worker-0",
-					Namespace: "bar",
+					Name:      "bar-worker-12",
+					Namespace: "foo",
 					Labels: map[string]string{
 						kubeflow.OperatorNameLabel: kubeflow.OperatorName,
-						kubeflow.JobNameLabel:      "foo",
+						kubeflow.JobNameLabel:      "bar",
 						kubeflow.JobRoleLabel:      "worker",
-						kubeflow.ReplicaIndexLabel: "0",
+						kubeflow.ReplicaTypeLabel:  "worker",
+						kubeflow.ReplicaIndexLabel: "12",
 					},
 				},
 				Spec: corev1.PodSpec{
-					Hostname:  "foo-worker-0",
-					Subdomain: "foo",
+					HostNetwork: true,
+					DNSPolicy:   corev1.DNSClusterFirstWithHostNet,
+					Hostname:    "bar-worker-12",
+					Subdomain:   "bar",
 					DNSConfig: &corev1.PodDNSConfig{
-						Searches: []string{"foo.bar.svc.cluster.local"},
+						Searches: []string{"bar.foo.svc.cluster.local"},
 					},
 					RestartPolicy: corev1.RestartPolicyNever,
 					Containers: []corev1.Container{
 						{
-							Command: []string{"/usr/sbin/sshd", "-De"},
+							Command: []string{"/entrypoint.sh"},
 							VolumeMounts: []corev1.VolumeMount{
-								{Name: "ssh-auth", MountPath: "/root/.ssh"},
+								{Name: "ssh-auth", MountPath: "/home/mpiuser/.ssh"},
 							},
-							Env: workerEnvVars,
+							Env: joinEnvVars(corev1.EnvVar{Name: "FOO", Value: "bar"}, workerEnvVars),
 						},
 					},
 					Volumes: []corev1.Volume{
@@ -1340,9 +3441,14 @@ func TestNewLauncherAndWorker(t *testing.T) {
 							Name: "ssh-auth",
 							VolumeSource: corev1.VolumeSource{
 								Secret: &corev1.SecretVolumeSource{
-									DefaultMode: ptr.To[int32](0600),
-									SecretName:  "foo-ssh",
-									Items:       sshVolumeItems,
+									SecretName: "bar-ssh",
+									Items: []corev1.KeyToPath{
+										{Key: corev1.SSHAuthPrivateKey, Path: sshPrivateKeyFile, Mode: ptr.To[int32](0600)},
+										{Key: sshPublicKey, Path: sshPublicKeyFile},
+										{Key: sshPublicKey, Path: sshAuthorizedKeysFile},
+										{Key: sshHostPrivateKey, Path: sshHostKeyFile, Mode: ptr.To[int32](0600)},
+										{Key: sshKnownHosts, Path: sshKnownHostsFile},
+									},
 								},
 							},
 						},
@@ -1350,522 +3456,1825 @@ func TestNewLauncherAndWorker(t *testing.T) {
 				},
 			},
 		},
-		"launcher-as-worker": {
-			job: kubeflow.GroupJob{
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := tc.job.DeepCopy()
+			scheme.Scheme.Default(job)
+			ctrl := &GroupJobController{}
+			launcher := ctrl.newLauncherJob(job)
+			if !metav1.IsControlledBy(launcher, job) {
+				t.Errorf("Created launcher Pod is not controlled by Job")
+			}
+			wantLauncher := tc.wantLauncher.DeepCopy()
+			setSpecHashAnnotation(&wantLauncher.ObjectMeta, ctrl.replicaSpecHash(job, kubeflow.MPIReplicaTypeLauncher))
+			if diff := cmp.Diff(wantLauncher, launcher, ignoreReferences); diff != "" {
+				t.Errorf("Unexpected launcher pod (-want,+got):\n%s", diff)
+			}
+			worker := ctrl.newWorker(job, tc.workerIndex)
+			if !metav1.IsControlledBy(worker, job) {
+				t.Errorf("Created worker Pod is not controlled by Job")
+			}
+			wantWorker := tc.wantWorker.DeepCopy()
+			setSpecHashAnnotation(&wantWorker.ObjectMeta, ctrl.replicaSpecHash(job, kubeflow.MPIReplicaTypeWorker))
+			if diff := cmp.Diff(wantWorker, worker, ignoreReferences); diff != "" {
+				t.Errorf("Unexpected launcher pod (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewConfigMap(t *testing.T) {
+	testCases := map[string]struct {
+		mpiJob         *kubeflow.GroupJob
+		workerReplicas int32
+		wantCM         *corev1.ConfigMap
+	}{
+		"OpenMPI without slots, enable launcher as worker": {
+			mpiJob: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-without-slots",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					MPIImplementation:   kubeflow.MPIImplementationOpenMPI,
+					RunLauncherAsWorker: ptr.To(true),
+				},
+			},
+			workerReplicas: 2,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-without-slots-config",
+					Namespace: "tenant-a",
+					Labels: map[string]string{
+						"app": "openmpi-without-slots",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "openmpi-without-slots-launcher.openmpi-without-slots.tenant-a.svc slots=1\nopenmpi-without-slots-worker-0.openmpi-without-slots.tenant-a.svc slots=1\nopenmpi-without-slots-worker-1.openmpi-without-slots.tenant-a.svc slots=1\n",
+				},
+			},
+		},
+		"OpenMPI without slots, zero explicit workers": {
+			mpiJob: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-without-slots",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					MPIImplementation:   kubeflow.MPIImplementationOpenMPI,
+					RunLauncherAsWorker: ptr.To(true),
+				},
+			},
+			workerReplicas: 0,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-without-slots-config",
+					Namespace: "tenant-a",
+					Labels: map[string]string{
+						"app": "openmpi-without-slots",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "openmpi-without-slots-launcher.openmpi-without-slots.tenant-a.svc slots=1\n",
+				},
+			},
+		},
+		"OpenMPI without slots, disable launcher as worker": {
+			mpiJob: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-without-slots",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+				},
+			},
+			workerReplicas: 2,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-without-slots-config",
+					Namespace: "tenant-a",
+					Labels: map[string]string{
+						"app": "openmpi-without-slots",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "openmpi-without-slots-worker-0.openmpi-without-slots.tenant-a.svc slots=1\nopenmpi-without-slots-worker-1.openmpi-without-slots.tenant-a.svc slots=1\n",
+				},
+			},
+		},
+		"OpenMPI with worker groups": {
+			mpiJob: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-groups",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker:    ptr.To[int32](2),
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas: ptr.To[int32](1),
+						},
+						"GPU": {
+							Replicas:       ptr.To[int32](2),
+							SlotsPerWorker: ptr.To[int32](8),
+						},
+						"HighMem": {
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+			workerReplicas: 1,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-groups-config",
+					Namespace: "tenant-a",
+					Labels: map[string]string{
+						"app": "openmpi-groups",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "openmpi-groups-worker-0.openmpi-groups.tenant-a.svc slots=2\nopenmpi-groups-gpu-0.openmpi-groups.tenant-a.svc slots=8\nopenmpi-groups-gpu-1.openmpi-groups.tenant-a.svc slots=8\nopenmpi-groups-highmem-0.openmpi-groups.tenant-a.svc slots=2\n",
+				},
+			},
+		},
+		"IntelMPI with slots": {
+			mpiJob: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "intelmpi-with-slots",
+					Namespace: "project-x",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					SlotsPerWorker:    ptr.To[int32](10),
+					MPIImplementation: kubeflow.MPIImplementationIntel,
+				},
+			},
+			workerReplicas: 1,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "intelmpi-with-slots-config",
+					Namespace: "project-x",
+					Labels: map[string]string{
+						"app": "intelmpi-with-slots",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "intelmpi-with-slots-worker-0.intelmpi-with-slots.project-x.svc:10\n",
+				},
+			},
+		},
+		"MPICH with slots": {
+			mpiJob: &kubeflow.GroupJob{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "foo",
-					Namespace: "bar",
+					Name:      "mpich-with-slots",
+					Namespace: "project-x",
 				},
 				Spec: kubeflow.GroupJobSpec{
-					RunLauncherAsWorker: ptr.To(true),
-					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
-						kubeflow.MPIReplicaTypeLauncher: {
-							Template: corev1.PodTemplateSpec{
-								Spec: corev1.PodSpec{
-									Containers: []corev1.Container{{}},
-								},
-							},
-						},
-						kubeflow.MPIReplicaTypeWorker: {
-							Template: corev1.PodTemplateSpec{
-								Spec: corev1.PodSpec{
-									Containers: []corev1.Container{{}},
-								},
-							},
-						},
-					},
+					SlotsPerWorker:    ptr.To[int32](10),
+					MPIImplementation: kubeflow.MPIImplementationMPICH,
 				},
 			},
-			wantLauncher: batchv1.Job{
+			workerReplicas: 1,
+			wantCM: &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "foo-launcher",
-					Namespace: "bar",
+					Name:      "mpich-with-slots-config",
+					Namespace: "project-x",
 					Labels: map[string]string{
-						"app": "foo",
+						"app": "mpich-with-slots",
 					},
 				},
-				Spec: batchv1.JobSpec{
-					Template: corev1.PodTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Labels: map[string]string{
-								kubeflow.OperatorNameLabel: kubeflow.OperatorName,
-								kubeflow.JobNameLabel:      "foo",
-								kubeflow.JobRoleLabel:      "launcher",
-								kubeflow.ReplicaIndexLabel: "0",
-							},
-						},
-						Spec: corev1.PodSpec{
-							Hostname:      "foo-launcher",
-							Subdomain:     "foo",
-							RestartPolicy: corev1.RestartPolicyOnFailure,
-							Containers: []corev1.Container{
-								{
-									Env: joinEnvVars(
-										launcherEnvVars,
-										ompiEnvVars,
-										corev1.EnvVar{Name: openMPISlotsEnv, Value: "1"},
-									),
-									VolumeMounts: []corev1.VolumeMount{
-										{Name: "ssh-auth", MountPath: "/root/.ssh"},
-										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
-									},
-								},
-							},
-							Volumes: []corev1.Volume{
-								{
-									Name: "ssh-auth",
-									VolumeSource: corev1.VolumeSource{
-										Secret: &corev1.SecretVolumeSource{
-											DefaultMode: ptr.To[int32](0600),
-											SecretName:  "foo-ssh",
-											Items:       sshVolumeItems,
-										},
-									},
-								},
-								{
-									Name: "mpi-job-config",
-									VolumeSource: corev1.VolumeSource{
-										ConfigMap: &corev1.ConfigMapVolumeSource{
-											LocalObjectReference: corev1.LocalObjectReference{
-												Name: "foo-config",
-											},
-											Items: configVolumeItems,
-										},
-									},
-								},
-							},
-						},
-					},
+				Data: map[string]string{
+					"hostfile": "mpich-with-slots-worker-0.mpich-with-slots.project-x.svc:10\n",
 				},
 			},
-			wantWorker: corev1.Pod{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "foo-worker-0",
-					Namespace: "bar",
-					Labels: map[string]string{
-						kubeflow.OperatorNameLabel: kubeflow.OperatorName,
-						kubeflow.JobNameLabel:      "foo",
-						kubeflow.JobRoleLabel:      "worker",
-						kubeflow.ReplicaIndexLabel: "1",
-					},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cm := newConfigMap(tc.mpiJob, tc.workerReplicas)
+			if !metav1.IsControlledBy(cm, tc.mpiJob) {
+				t.Errorf("Created configMap is not controlled by GroupJob")
+			}
+			if diff := cmp.Diff(tc.wantCM, cm, ignoreReferences); len(diff) != 0 {
+				t.Errorf("Unexpected configMap (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func joinEnvVars(evs ...interface{}) []corev1.EnvVar {
+	var result []corev1.EnvVar
+	for _, ev := range evs {
+		switch v := ev.(type) {
+		case corev1.EnvVar:
+			result = append(result, v)
+		case []corev1.EnvVar:
+			result = append(result, v...)
+		default:
+			panic("must by of type EnvVar or []EnvVar")
+		}
+	}
+	return result
+}
+
+func mockJobPod(job *batchv1.Job) *corev1.Pod {
+	job.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"controller-uid": string(uuid.NewUUID()),
+		},
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-" + rand.String(5),
+			Labels:    job.Spec.Selector.MatchLabels,
+			Namespace: job.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+			},
+		},
+	}
+}
+
+func (f *fixture) newFakeGroupJobController() *GroupJobController {
+	kubeClient := k8sfake.NewSimpleClientset(f.kubeObjects...)
+
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeClient, noResyncPeriodFunc())
+	return &GroupJobController{
+		recorder:  &record.FakeRecorder{},
+		podLister: k8sI.Core().V1().Pods().Lister(),
+	}
+}
+
+func TestQueueGroupJob(t *testing.T) {
+	cases := map[string]struct {
+		// running sets up a running GroupJob, which takes the only slot of
+		// the queue.
+		running      bool
+		queued       bool
+		wantAdmitted bool
+	}{
+		"new job is queued": {
+			running: true,
+		},
+		"queued job is admitted": {
+			queued:       true,
+			wantAdmitted: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			f := newFixture(t, "")
+			f.queueConfig = &QueueConfig{Default: QueueLimits{MaxJobs: ptr.To[int32](1)}}
+			if tc.running {
+				startTime := metav1.Now()
+				running := newGroupJob("running", ptr.To[int32](2), &startTime, nil)
+				updateGroupJobConditions(running, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/running is created.")
+				f.setUpGroupJob(running)
+			}
+			var replicas int32 = 2
+			mpiJob := newGroupJob("test", &replicas, nil, nil)
+			if tc.queued {
+				mpiJob.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+				mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+				msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+				msg = fmt.Sprintf("GroupJob %s/%s is queued.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+				updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+			}
+			f.setUpGroupJob(mpiJob)
+
+			patch := suspendPatch
+			if tc.wantAdmitted {
+				patch = resumePatch
+			}
+			f.actions = append(f.actions, core.NewPatchAction(schema.GroupVersionResource{Resource: "groupjobs"}, mpiJob.Namespace, mpiJob.Name, types.MergePatchType, patch))
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			mpiJobCopy.Spec.RunPolicy.Suspend = ptr.To(!tc.wantAdmitted)
+			f.expectCreateServiceAction(newJobService(mpiJobCopy))
+			cfgMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(cfgMap, mpiJobCopy, nil)
+			f.expectCreateConfigMapAction(cfgMap)
+			secret, err := newSSHAuthSecret(mpiJobCopy)
+			if err != nil {
+				t.Fatalf("Failed creating secret: %v", err)
+			}
+			f.expectCreateSecretAction(secret)
+			fmjc := f.newFakeGroupJobController()
+			if tc.wantAdmitted {
+				for i := 0; i < int(replicas); i++ {
+					f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
+				}
+			}
+			f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
+
+			if tc.wantAdmitted {
+				msg := fmt.Sprintf("GroupJob %s/%s is admitted.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobQueued, corev1.ConditionFalse, mpiJobAdmittedReason, msg)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionFalse, mpiJobResumedReason, "GroupJob resumed")
+				startTime := metav1.NewTime(fakeClock.Now())
+				setUpGroupJobTimestamp(mpiJobCopy, &startTime, nil)
+			} else {
+				msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+				msg = fmt.Sprintf("GroupJob %s/%s is at position 1 in the queue of namespace %s.", mpiJob.Namespace, mpiJob.Name, mpiJob.Namespace)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+				msg = fmt.Sprintf("GroupJob %s/%s is queued.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+				msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+			}
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
 				},
-				Spec: corev1.PodSpec{
-					Hostname:  "foo-worker-0",
-					Subdomain: "foo",
-					DNSConfig: &corev1.PodDNSConfig{
-						Searches: []string{"foo.bar.svc.cluster.local"},
-					},
-					RestartPolicy: corev1.RestartPolicyNever,
-					Containers: []corev1.Container{
-						{
-							Command: []string{"/usr/sbin/sshd", "-De"},
-							VolumeMounts: []corev1.VolumeMount{
-								{Name: "ssh-auth", MountPath: "/root/.ssh"},
-							},
-							Env: workerEnvVars,
-						},
-					},
-					Volumes: []corev1.Volume{
-						{
-							Name: "ssh-auth",
-							VolumeSource: corev1.VolumeSource{
-								Secret: &corev1.SecretVolumeSource{
-									DefaultMode: ptr.To[int32](0600),
-									SecretName:  "foo-ssh",
-									Items:       sshVolumeItems,
-								},
-							},
+			}
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.runWithClock(getKey(mpiJob, t), fakeClock)
+		})
+	}
+}
+
+func TestQueuePosition(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	highPriority := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 100}
+	type queueJob struct {
+		name    string
+		workers int32
+		// age is how long before now the GroupJob was created.
+		age           time.Duration
+		running       bool
+		priorityClass string
+		gpus          string
+	}
+	tests := map[string]struct {
+		jobs         []queueJob
+		limits       QueueLimits
+		wantPosition map[string]int
+		wantAdmitted []string
+	}{
+		"FIFO": {
+			jobs: []queueJob{
+				{name: "running", workers: 2, running: true},
+				{name: "second", workers: 2},
+				{name: "first", workers: 2, age: time.Minute},
+			},
+			limits:       QueueLimits{MaxWorkers: ptr.To[int32](4)},
+			wantPosition: map[string]int{"first": 1, "second": 2},
+			wantAdmitted: []string{"first"},
+		},
+		"priority before creation time": {
+			jobs: []queueJob{
+				{name: "low", workers: 2, age: time.Minute},
+				{name: "high", workers: 2, priorityClass: highPriority.Name},
+			},
+			limits:       QueueLimits{MaxJobs: ptr.To[int32](1)},
+			wantPosition: map[string]int{"high": 1, "low": 2},
+			wantAdmitted: []string{"high"},
+		},
+		"head of the queue blocks": {
+			jobs: []queueJob{
+				{name: "running", workers: 2, running: true},
+				{name: "large", workers: 3, age: time.Minute},
+				{name: "small", workers: 1},
+			},
+			limits:       QueueLimits{MaxWorkers: ptr.To[int32](4)},
+			wantPosition: map[string]int{"large": 1, "small": 2},
+		},
+		"job exceeding the limits alone is skipped": {
+			jobs: []queueJob{
+				{name: "huge", workers: 8, age: time.Minute},
+				{name: "small", workers: 1},
+			},
+			limits:       QueueLimits{MaxWorkers: ptr.To[int32](4)},
+			wantPosition: map[string]int{"small": 1},
+			wantAdmitted: []string{"small"},
+		},
+		"resources": {
+			jobs: []queueJob{
+				{name: "running", workers: 1, running: true, gpus: "8"},
+				{name: "queued", workers: 1, gpus: "8"},
+			},
+			limits: QueueLimits{MaxResources: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("8"),
+			}},
+			wantPosition: map[string]int{"queued": 1},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, "")
+			f.queueConfig = &QueueConfig{Default: tc.limits}
+			f.setUpPriorityClass(highPriority)
+			var jobs []*kubeflow.GroupJob
+			for _, job := range tc.jobs {
+				mpiJob := newGroupJob(job.name, ptr.To(job.workers), nil, nil)
+				mpiJob.CreationTimestamp = metav1.NewTime(now.Add(-job.age))
+				msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+				if job.running {
+					mpiJob.Status.StartTime = ptr.To(metav1.NewTime(now))
+				} else {
+					mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+					msg = fmt.Sprintf("GroupJob %s/%s is queued.", mpiJob.Namespace, mpiJob.Name)
+					updateGroupJobConditions(mpiJob, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+					updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+				}
+				if len(job.priorityClass) != 0 {
+					mpiJob.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{PriorityClass: job.priorityClass}
+				}
+				if len(job.gpus) != 0 {
+					mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse(job.gpus),
+					}
+				}
+				f.setUpGroupJob(mpiJob)
+				jobs = append(jobs, mpiJob)
+			}
+			c, _, _ := f.newController(clock.RealClock{})
+
+			gotPosition := map[string]int{}
+			var gotAdmitted []string
+			for _, job := range jobs {
+				if !isQueued(job) {
+					continue
+				}
+				alone := queueUsage{}
+				alone.add(job)
+				if !alone.within(tc.limits) {
+					continue
+				}
+				position, admit, err := c.queuePosition(job, tc.limits)
+				if err != nil {
+					t.Fatalf("Getting the position of %s: %v", job.Name, err)
+				}
+				gotPosition[job.Name] = position
+				if admit {
+					gotAdmitted = append(gotAdmitted, job.Name)
+				}
+			}
+			if diff := cmp.Diff(tc.wantPosition, gotPosition); diff != "" {
+				t.Errorf("Unexpected positions (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantAdmitted, gotAdmitted); diff != "" {
+				t.Errorf("Unexpected admitted GroupJobs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQueueSuspendedGroupJob(t *testing.T) {
+	tests := map[string]struct {
+		suspend       bool
+		queued        corev1.ConditionStatus
+		queuedReason  string
+		running       bool
+		wantSuspended bool
+		wantQueued    corev1.ConditionStatus
+		wantReason    string
+	}{
+		"admitted job stays admitted": {
+			queued:       corev1.ConditionFalse,
+			queuedReason: mpiJobAdmittedReason,
+			running:      true,
+			wantQueued:   corev1.ConditionFalse,
+			wantReason:   mpiJobAdmittedReason,
+		},
+		"suspended admitted job leaves the queue": {
+			suspend:       true,
+			queued:        corev1.ConditionFalse,
+			queuedReason:  mpiJobAdmittedReason,
+			wantSuspended: true,
+			wantQueued:    corev1.ConditionFalse,
+			wantReason:    mpiJobLeftQueueReason,
+		},
+		"job resumed after leaving the queue is queued again": {
+			queued:        corev1.ConditionFalse,
+			queuedReason:  mpiJobLeftQueueReason,
+			running:       true,
+			wantSuspended: true,
+			wantQueued:    corev1.ConditionTrue,
+			wantReason:    mpiJobQueuedReason,
+		},
+		"job resumed after leaving the queue is admitted if it fits": {
+			queued:       corev1.ConditionFalse,
+			queuedReason: mpiJobLeftQueueReason,
+			wantQueued:   corev1.ConditionFalse,
+			wantReason:   mpiJobAdmittedReason,
+		},
+		"queued job resumed outside of the queue is suspended again": {
+			queued:        corev1.ConditionTrue,
+			queuedReason:  mpiJobQueuedReason,
+			running:       true,
+			wantSuspended: true,
+			wantQueued:    corev1.ConditionTrue,
+			wantReason:    mpiJobQueuedReason,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, "")
+			f.queueConfig = &QueueConfig{Default: QueueLimits{MaxJobs: ptr.To[int32](1)}}
+			startTime := metav1.Now()
+			if tc.running {
+				running := newGroupJob("running", ptr.To[int32](2), &startTime, nil)
+				updateGroupJobConditions(running, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/running is created.")
+				f.setUpGroupJob(running)
+			}
+			mpiJob := newGroupJob("test", ptr.To[int32](2), &startTime, nil)
+			mpiJob.Spec.RunPolicy.Suspend = ptr.To(tc.suspend)
+			updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/test is created.")
+			updateGroupJobConditions(mpiJob, kubeflow.JobQueued, tc.queued, tc.queuedReason, "")
+			f.setUpGroupJob(mpiJob)
+			c, _, _ := f.newController(clock.RealClock{})
+
+			mpiJob = mpiJob.DeepCopy()
+			if err := c.queueGroupJob(mpiJob); err != nil {
+				t.Fatalf("Queueing GroupJob: %v", err)
+			}
+			if got := isGroupJobSuspended(mpiJob); got != tc.wantSuspended {
+				t.Errorf("GroupJob suspended: %t, want %t", got, tc.wantSuspended)
+			}
+			got, err := f.client.KubeflowV2beta1().GroupJobs(mpiJob.Namespace).Get(context.Background(), mpiJob.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting GroupJob: %v", err)
+			}
+			if isGroupJobSuspended(got) != tc.wantSuspended {
+				t.Errorf("Suspension of the GroupJob wasn't patched")
+			}
+			queued := getCondition(mpiJob.Status, kubeflow.JobQueued)
+			if queued == nil || queued.Status != tc.wantQueued || queued.Reason != tc.wantReason {
+				t.Errorf("Unexpected Queued condition %+v, want status %s and reason %s", queued, tc.wantQueued, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestLoadQueueConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "queue.yaml")
+	data := `default:
+  maxJobs: 2
+namespaces:
+  team-a:
+    maxWorkers: 16
+    maxResources:
+      nvidia.com/gpu: "64"
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("Writing config: %v", err)
+	}
+	config, err := LoadQueueConfig(path)
+	if err != nil {
+		t.Fatalf("Loading config: %v", err)
+	}
+	want := &QueueConfig{
+		Default: QueueLimits{MaxJobs: ptr.To[int32](2)},
+		Namespaces: map[string]QueueLimits{
+			"team-a": {
+				MaxWorkers:   ptr.To[int32](16),
+				MaxResources: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("64")},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, config); diff != "" {
+		t.Errorf("Unexpected config (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.Default, config.limits("team-b")); diff != "" {
+		t.Errorf("Unexpected limits of a namespace without limits (-want,+got):\n%s", diff)
+	}
+
+	if err := os.WriteFile(path, []byte("default:\n  maxJob: 2\n"), 0644); err != nil {
+		t.Fatalf("Writing config: %v", err)
+	}
+	if _, err := LoadQueueConfig(path); err == nil {
+		t.Error("Loading a config with an unknown field succeeded")
+	}
+}
+
+func TestSetTopologyAffinity(t *testing.T) {
+	f := newFixture(t, "")
+	mpiJob := newGroupJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{TopologyKey: "example.com/rack"}
+	scheme.Scheme.Default(mpiJob)
+	mpiJob.Spec.RunLauncherAsWorker = ptr.To(true)
+	c := f.newFakeGroupJobController()
+
+	want := &corev1.Affinity{
+		PodAffinity: &corev1.PodAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: topologyAffinityWeight,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+							kubeflow.JobNameLabel:      "test",
+							kubeflow.JobRoleLabel:      worker,
 						},
 					},
+					TopologyKey: "example.com/rack",
 				},
-			},
+			}},
 		},
-		"overrides": {
-			job: kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "bar",
-					Namespace: "foo",
+	}
+	if diff := cmp.Diff(want, c.newWorker(mpiJob, 0).Spec.Affinity); diff != "" {
+		t.Errorf("Unexpected affinity of the worker (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want, c.newLauncherPodTemplate(mpiJob).Spec.Affinity); diff != "" {
+		t.Errorf("Unexpected affinity of the launcher (-want,+got):\n%s", diff)
+	}
+
+	mpiJob.Spec.RunLauncherAsWorker = nil
+	if affinity := c.newLauncherPodTemplate(mpiJob).Spec.Affinity; affinity != nil {
+		t.Errorf("Launcher not running as a worker got affinity %v", affinity)
+	}
+	mpiJob.Spec.RunPolicy.SchedulingPolicy = nil
+	if affinity := c.newWorker(mpiJob, 0).Spec.Affinity; affinity != nil {
+		t.Errorf("Worker without topology key got affinity %v", affinity)
+	}
+}
+
+func TestOrderHostsByTopology(t *testing.T) {
+	mpiJob := newGroupJob("test", ptr.To[int32](5), nil, nil)
+	mpiJob.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{TopologyKey: "example.com/rack"}
+	scheme.Scheme.Default(mpiJob)
+	f := newFixture(t, "")
+	// node-d is not labeled with the topology key.
+	for name, domain := range map[string]string{"node-a": "rack-2", "node-b": "rack-1", "node-c": "rack-2", "node-d": ""} {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if len(domain) != 0 {
+			node.Labels = map[string]string{"example.com/rack": domain}
+		}
+		f.setUpNode(node)
+	}
+	nodes := []string{"node-c", "node-d", "node-a", "node-b", ""}
+	var runningPods []*corev1.Pod
+	for i, node := range nodes {
+		if len(node) == 0 {
+			continue
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: workerName(mpiJob, i), Namespace: mpiJob.Namespace},
+			Spec:       corev1.PodSpec{NodeName: node},
+		}
+		runningPods = append(runningPods, pod)
+	}
+	c, _, _ := f.newController(clock.RealClock{})
+
+	topology, err := c.workerTopology(mpiJob, runningPods)
+	if err != nil {
+		t.Fatalf("Getting the topology: %v", err)
+	}
+	configMap := newConfigMap(mpiJob, 5)
+	updateDiscoverHostsInConfigMap(configMap, mpiJob, runningPods)
+	orderHostsByTopology(configMap, mpiJob, runningPods, topology)
+
+	order := []int{3, 2, 0, 1, 4}
+	var hostfile, discoverHosts string
+	discoverHosts = "#!/bin/sh\n"
+	for _, i := range order {
+		hostfile += fmt.Sprintf("test-worker-%d.test.default.svc slots=1\n", i)
+		if i != 4 {
+			discoverHosts += fmt.Sprintf("echo test-worker-%d.test.default.svc\n", i)
+		}
+	}
+	want := map[string]string{
+		hostfileName:            hostfile,
+		discoverHostsScriptName: discoverHosts,
+	}
+	if diff := cmp.Diff(want, configMap.Data); diff != "" {
+		t.Errorf("Unexpected ConfigMap data (-want,+got):\n%s", diff)
+	}
+}
+
+func TestScheduleTimeout(t *testing.T) {
+	cases := map[string]struct {
+		action      kubeflow.ScheduleTimeoutAction
+		pendingFor  time.Duration
+		queueConfig *QueueConfig
+		wantFailed  bool
+		wantRequeue bool
+	}{
+		"not exceeded": {
+			action:     kubeflow.ScheduleTimeoutActionFail,
+			pendingFor: 30 * time.Second,
+		},
+		"fail": {
+			action:     kubeflow.ScheduleTimeoutActionFail,
+			pendingFor: 2 * time.Minute,
+			wantFailed: true,
+		},
+		"requeue without queue": {
+			action:      kubeflow.ScheduleTimeoutActionRequeue,
+			pendingFor:  2 * time.Minute,
+			wantRequeue: true,
+		},
+		"requeue back in the queue": {
+			action:      kubeflow.ScheduleTimeoutActionRequeue,
+			pendingFor:  2 * time.Minute,
+			queueConfig: &QueueConfig{},
+			wantRequeue: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			f := newFixture(t, "")
+			f.queueConfig = tc.queueConfig
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			// The launcher is running, and the workers are pending.
+			var replicas int32 = 2
+			mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+			mpiJob.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{
+				ScheduleTimeoutSeconds: ptr.To[int32](60),
+				ScheduleTimeoutAction:  tc.action,
+			}
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			f.setUpGroupJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			f.setUpService(newJobService(mpiJobCopy))
+			configMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+			f.setUpConfigMap(configMap)
+			secret, err := newSSHAuthSecret(mpiJobCopy)
+			if err != nil {
+				t.Fatalf("Creating SSH auth secret: %v", err)
+			}
+			f.setUpSecret(secret)
+
+			fmjc := f.newFakeGroupJobController()
+			launcher := fmjc.newLauncherJob(mpiJobCopy)
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Spec.NodeName = "node-a"
+			launcherPod.Status.Phase = corev1.PodRunning
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+			for i := 0; i < int(replicas); i++ {
+				worker := fmjc.newWorker(mpiJobCopy, i)
+				worker.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-tc.pendingFor))
+				worker.Status.Phase = corev1.PodPending
+				f.setUpPod(worker)
+			}
+
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Active: 1,
 				},
-				Spec: kubeflow.GroupJobSpec{
-					SSHAuthMountPath:  "/home/mpiuser/.ssh",
-					SlotsPerWorker:    ptr.To[int32](5),
-					MPIImplementation: kubeflow.MPIImplementationIntel,
-					RunPolicy: kubeflow.RunPolicy{
-						TTLSecondsAfterFinished: ptr.To[int32](1),
-						ActiveDeadlineSeconds:   ptr.To[int64](2),
-						BackoffLimit:            ptr.To[int32](3),
-					},
-					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
-						kubeflow.MPIReplicaTypeLauncher: {
-							RestartPolicy: kubeflow.RestartPolicyOnFailure,
-							Template: corev1.PodTemplateSpec{
-								ObjectMeta: metav1.ObjectMeta{
-									Labels: map[string]string{"foo": "bar"},
-								},
-								Spec: corev1.PodSpec{
-									HostNetwork: true,
-									Containers: []corev1.Container{
-										{
-											Env: []corev1.EnvVar{
-												{Name: "FOO", Value: "bar"},
-											},
-											SecurityContext: &corev1.SecurityContext{
-												RunAsUser: ptr.To[int64](1000),
-											},
-											VolumeMounts: []corev1.VolumeMount{
-												{Name: "fool-vol", MountPath: "/mnt/foo"},
-											},
-										},
-										{},
-									},
-									Volumes: []corev1.Volume{
-										{Name: "foo-vol"},
-									},
-								},
-							},
-						},
-						kubeflow.MPIReplicaTypeWorker: {
-							Template: corev1.PodTemplateSpec{
-								Spec: corev1.PodSpec{
-									HostNetwork: true,
-									Containers: []corev1.Container{
-										{
-											Command: []string{"/entrypoint.sh"},
-											Env: []corev1.EnvVar{
-												{Name: "FOO", Value: "bar"},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
 				},
+			}
+			setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+			msg = fmt.Sprintf("GroupJob %s/%s has pods that stayed unscheduled for more than 1m0s", mpiJob.Namespace, mpiJob.Name)
+			if tc.wantFailed {
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobScheduleTimeoutReason, msg)
+			}
+			if tc.wantRequeue {
+				f.actions = append(f.actions, core.NewPatchAction(schema.GroupVersionResource{Resource: "groupjobs"}, mpiJob.Namespace, mpiJob.Name, types.MergePatchType, suspendPatch))
+				launcherCopy := launcher.DeepCopy()
+				launcherCopy.Spec.Suspend = ptr.To(true)
+				f.expectUpdateJobAction(launcherCopy)
+				for i := 0; i < int(replicas); i++ {
+					f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, workerName(mpiJob, i)))
+				}
+
+				// The reason of the suspension is kept.
+				mpiJobCopy.Spec.RunPolicy.Suspend = ptr.To(true)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobScheduleTimeoutReason, msg)
+				if tc.queueConfig != nil {
+					updateGroupJobConditions(mpiJobCopy, kubeflow.JobQueued, corev1.ConditionTrue, mpiJobQueuedReason, msg)
+				}
+				msg = fmt.Sprintf("GroupJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+			}
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.runWithClock(getKey(mpiJob, t), fakeClock)
+
+			stored, err := f.client.KubeflowV2beta1().GroupJobs(mpiJob.Namespace).Get(context.TODO(), mpiJob.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting GroupJob: %v", err)
+			}
+			if isGroupJobSuspended(stored) != tc.wantRequeue {
+				t.Errorf("GroupJob suspended: %t, want %t", isGroupJobSuspended(stored), tc.wantRequeue)
+			}
+		})
+	}
+}
+
+func TestUnscheduledSince(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	pod := func(age time.Duration, phase corev1.PodPhase, node string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Spec:       corev1.PodSpec{NodeName: node},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	cases := map[string]struct {
+		pods      []*corev1.Pod
+		wantSince time.Time
+		wantOK    bool
+	}{
+		"no pods": {},
+		"all scheduled": {
+			pods: []*corev1.Pod{
+				pod(time.Hour, corev1.PodRunning, "node-a"),
+				// Bound, but its containers are not started yet.
+				pod(time.Hour, corev1.PodPending, "node-b"),
 			},
-			workerIndex: 12,
-			wantLauncher: batchv1.Job{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "bar-launcher",
-					Namespace: "foo",
-					Labels: map[string]string{
-						"app": "bar",
-					},
-				},
-				Spec: batchv1.JobSpec{
-					TTLSecondsAfterFinished: ptr.To[int32](1),
-					ActiveDeadlineSeconds:   ptr.To[int64](2),
-					BackoffLimit:            ptr.To[int32](3),
-					Template: corev1.PodTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Labels: map[string]string{
-								"foo":                      "bar",
-								kubeflow.OperatorNameLabel: kubeflow.OperatorName,
-								kubeflow.JobNameLabel:      "bar",
-								kubeflow.JobRoleLabel:      "launcher",
-							},
-						},
-						Spec: corev1.PodSpec{
-							HostNetwork:   true,
-							DNSPolicy:     corev1.DNSClusterFirstWithHostNet,
-							Hostname:      "bar-launcher",
-							Subdomain:     "bar",
-							RestartPolicy: corev1.RestartPolicyOnFailure,
-							Containers: []corev1.Container{
-								{
-									SecurityContext: &corev1.SecurityContext{
-										RunAsUser: ptr.To[int64](1000),
-									},
-									Env: joinEnvVars(
-										corev1.EnvVar{Name: "FOO", Value: "bar"},
-										launcherEnvVars,
-										intelEnvVars,
-										corev1.EnvVar{Name: "I_MPI_PERHOST", Value: "5"},
-										nvidiaDisableEnvVars),
-									VolumeMounts: []corev1.VolumeMount{
-										{Name: "fool-vol", MountPath: "/mnt/foo"},
-										{Name: "ssh-auth", MountPath: "/home/mpiuser/.ssh"},
-										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
-									},
-								},
-								{},
-							},
-							Volumes: []corev1.Volume{
-								{Name: "foo-vol"},
-								{
-									Name: "ssh-auth",
-									VolumeSource: corev1.VolumeSource{
-										Secret: &corev1.SecretVolumeSource{
-											SecretName: "bar-ssh",
-											Items:      sshVolumeItems,
-										},
-									},
-								},
-								{
-									Name: "mpi-job-config",
-									VolumeSource: corev1.VolumeSource{
-										ConfigMap: &corev1.ConfigMapVolumeSource{
-											LocalObjectReference: corev1.LocalObjectReference{
-												Name: "bar-config",
-											},
-											Items: configVolumeItems,
-										},
-									},
-								},
-							},
-						},
+		},
+		"oldest unscheduled pod": {
+			pods: []*corev1.Pod{
+				pod(time.Hour, corev1.PodRunning, "node-a"),
+				pod(time.Minute, corev1.PodPending, ""),
+				pod(2*time.Minute, corev1.PodPending, ""),
+			},
+			wantSince: now.Add(-2 * time.Minute),
+			wantOK:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			since, ok := unscheduledSince(tc.pods)
+			if ok != tc.wantOK || !since.Equal(tc.wantSince) {
+				t.Errorf("Got (%v, %t), want (%v, %t)", since, ok, tc.wantSince, tc.wantOK)
+			}
+		})
+	}
+}
+
+func disruptedPodStatus(reason string) corev1.PodStatus {
+	return corev1.PodStatus{
+		Phase: corev1.PodFailed,
+		Conditions: []corev1.PodCondition{{
+			Type:   corev1.DisruptionTarget,
+			Status: corev1.ConditionTrue,
+			Reason: reason,
+		}},
+		ContainerStatuses: []corev1.ContainerStatus{{
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "Error"},
+			},
+		}},
+	}
+}
+
+func oomKilledPodStatus() corev1.PodStatus {
+	return corev1.PodStatus{
+		Phase: corev1.PodFailed,
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name: "foo",
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+			},
+		}},
+	}
+}
+
+func TestPodFailureCause(t *testing.T) {
+	cases := map[string]struct {
+		status corev1.PodStatus
+		want   kubeflow.FailureCause
+	}{
+		"preempted": {
+			status: disruptedPodStatus(corev1.PodReasonPreemptionByScheduler),
+			want:   kubeflow.FailureCausePreempted,
+		},
+		"evicted through the Eviction API": {
+			status: disruptedPodStatus("EvictionByEvictionAPI"),
+			want:   kubeflow.FailureCauseEvicted,
+		},
+		"evicted by the kubelet": {
+			status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"},
+			want:   kubeflow.FailureCauseEvicted,
+		},
+		"node tainted": {
+			status: disruptedPodStatus("DeletionByTaintManager"),
+			want:   kubeflow.FailureCauseNodeLost,
+		},
+		"node removed": {
+			status: disruptedPodStatus("DeletionByPodGC"),
+			want:   kubeflow.FailureCauseNodeLost,
+		},
+		"node lost": {
+			status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "NodeLost"},
+			want:   kubeflow.FailureCauseNodeLost,
+		},
+		"OOM-killed": {
+			status: oomKilledPodStatus(),
+			want:   kubeflow.FailureCauseOOMKilled,
+		},
+		"exit code": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
 					},
-				},
+				}},
 			},
-			wantWorker: corev1.Pod{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "bar-worker-12",
-					Namespace: "foo",
-					Labels: map[string]string{
-						kubeflow.OperatorNameLabel: kubeflow.OperatorName,
-						kubeflow.JobNameLabel:      "bar",
-						kubeflow.JobRoleLabel:      "worker",
-						kubeflow.ReplicaIndexLabel: "12",
-					},
+			want: kubeflow.FailureCauseError,
+		},
+		"disruption not applied": {
+			status: func() corev1.PodStatus {
+				status := oomKilledPodStatus()
+				status.Conditions = []corev1.PodCondition{{
+					Type:   corev1.DisruptionTarget,
+					Status: corev1.ConditionFalse,
+					Reason: corev1.PodReasonPreemptionByScheduler,
+				}}
+				return status
+			}(),
+			want: kubeflow.FailureCauseOOMKilled,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := podFailureCause(&corev1.Pod{Status: tc.status}); got != tc.want {
+				t.Errorf("Got cause %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFailurePolicy(t *testing.T) {
+	cases := map[string]struct {
+		restartPolicy kubeflow.RestartPolicy
+		workerStatus  corev1.PodStatus
+		// failedWorkers have the workerStatus; 1 if unset.
+		failedWorkers  int
+		wantCause      kubeflow.FailureCause
+		backoffLimit   *int32
+		restartCount   int32
+		restartCounted bool
+		wantCount      int32
+		wantDelete     bool
+		// wantReason and wantMessage are of the Failed condition, if the
+		// GroupJob fails.
+		wantReason  string
+		wantMessage string
+	}{
+		"fail": {
+			// The exit code 137 is retryable, but the failure policy takes
+			// precedence.
+			restartPolicy: kubeflow.RestartPolicyExitCode,
+			workerStatus:  oomKilledPodStatus(),
+			wantCause:     kubeflow.FailureCauseOOMKilled,
+			wantReason:    mpiJobOOMKilledReason,
+			wantMessage:   "worker pod default/test-worker-0 was OOM-killed",
+		},
+		"restart is counted": {
+			workerStatus: disruptedPodStatus(corev1.PodReasonPreemptionByScheduler),
+			wantCause:    kubeflow.FailureCausePreempted,
+			wantCount:    1,
+		},
+		"counted restart deletes the worker": {
+			workerStatus:   disruptedPodStatus(corev1.PodReasonPreemptionByScheduler),
+			wantCause:      kubeflow.FailureCausePreempted,
+			restartCount:   1,
+			restartCounted: true,
+			wantCount:      1,
+			wantDelete:     true,
+		},
+		"restarts of workers failing together are counted": {
+			workerStatus:  disruptedPodStatus(corev1.PodReasonPreemptionByScheduler),
+			wantCause:     kubeflow.FailureCausePreempted,
+			failedWorkers: 2,
+			wantCount:     2,
+		},
+		"counted restarts delete the workers failing together": {
+			workerStatus:   disruptedPodStatus(corev1.PodReasonPreemptionByScheduler),
+			wantCause:      kubeflow.FailureCausePreempted,
+			failedWorkers:  2,
+			restartCount:   2,
+			restartCounted: true,
+			wantCount:      2,
+			wantDelete:     true,
+		},
+		"backoff limit reached": {
+			workerStatus: disruptedPodStatus(corev1.PodReasonPreemptionByScheduler),
+			wantCause:    kubeflow.FailureCausePreempted,
+			backoffLimit: ptr.To[int32](1),
+			restartCount: 1,
+			wantCount:    1,
+			wantReason:   mpiJobBackoffLimitExceededReason,
+			wantMessage:  "GroupJob default/test has reached the backoff limit of 1 restarts: Restarting worker pod test-worker-0, which was preempted",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			failed := max(tc.failedWorkers, 1)
+			f := newFixture(t, "")
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			// The first failed workers have the status of the case.
+			var replicas int32 = 4
+			mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].RestartPolicy = tc.restartPolicy
+			mpiJob.Spec.RunPolicy.FailurePolicy = []kubeflow.FailurePolicyRule{
+				{Cause: kubeflow.FailureCausePreempted, Action: kubeflow.FailureActionRestart},
+				{Cause: kubeflow.FailureCauseOOMKilled, Action: kubeflow.FailureActionFail},
+			}
+			mpiJob.Spec.RunPolicy.BackoffLimit = tc.backoffLimit
+			mpiJob.Status.RestartCount = tc.restartCount
+			if tc.restartCounted {
+				mpiJob.Status.LastRestartTime = ptr.To(metav1.NewTime(fakeClock.Now()))
+			}
+			f.setUpGroupJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			f.setUpService(newJobService(mpiJobCopy))
+			secret, err := newSSHAuthSecret(mpiJobCopy)
+			if err != nil {
+				t.Fatalf("Creating SSH auth secret: %v", err)
+			}
+			f.setUpSecret(secret)
+
+			fmjc := f.newFakeGroupJobController()
+			launcher := fmjc.newLauncherJob(mpiJobCopy)
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Status.Phase = corev1.PodRunning
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+
+			var runningPodList []*corev1.Pod
+			for i := 0; i < int(replicas); i++ {
+				worker := fmjc.newWorker(mpiJobCopy, i)
+				if i < failed {
+					worker.Status = tc.workerStatus
+				} else {
+					worker.Status.Phase = corev1.PodRunning
+					runningPodList = append(runningPodList, worker)
+				}
+				f.setUpPod(worker)
+			}
+
+			configMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+			f.setUpConfigMap(configMap)
+
+			if tc.wantDelete {
+				for i := 0; i < failed; i++ {
+					f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, workerName(mpiJob, i)))
+				}
+			}
+
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Active: 1,
 				},
-				Spec: corev1.PodSpec{
-					HostNetwork: true,
-					DNSPolicy:   corev1.DNSClusterFirstWithHostNet,
-					Hostname:    "bar-worker-12",
-					Subdomain:   "bar",
-					DNSConfig: &corev1.PodDNSConfig{
-						Searches: []string{"bar.foo.svc.cluster.local"},
-					},
-					RestartPolicy: corev1.RestartPolicyNever,
-					Containers: []corev1.Container{
-						{
-							Command: []string{"/entrypoint.sh"},
-							VolumeMounts: []corev1.VolumeMount{
-								{Name: "ssh-auth", MountPath: "/home/mpiuser/.ssh"},
-							},
-							Env: joinEnvVars(corev1.EnvVar{Name: "FOO", Value: "bar"}, workerEnvVars),
-						},
-					},
-					Volumes: []corev1.Volume{
-						{
-							Name: "ssh-auth",
-							VolumeSource: corev1.VolumeSource{
-								Secret: &corev1.SecretVolumeSource{
-									SecretName: "bar-ssh",
-									Items:      sshVolumeItems,
-								},
-							},
-						},
-					},
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector:      workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+					Active:        replicas - int32(failed),
+					Failed:        int32(failed),
+					FailedByCause: map[kubeflow.FailureCause]int32{tc.wantCause: int32(failed)},
 				},
+			}
+			setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+			mpiJobCopy.Status.RestartCount = tc.wantCount
+			if tc.wantCount != tc.restartCount {
+				mpiJobCopy.Status.LastRestartTime = ptr.To(metav1.NewTime(fakeClock.Now()))
+			}
+			if len(tc.wantReason) != 0 {
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, tc.wantReason, tc.wantMessage)
+			} else {
+				msg = fmt.Sprintf("%d/4 workers are restarting under the failure policy", failed)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobRestarting, corev1.ConditionTrue, mpiJobRestartingReason, msg)
+			}
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.runWithClock(getKey(mpiJob, t), fakeClock)
+		})
+	}
+}
+
+func TestWaitForCheckpoint(t *testing.T) {
+	cases := map[string]struct {
+		resumed      bool
+		requested    bool
+		checkpointed bool
+		waited       time.Duration
+		wantWaiting  bool
+		wantStatus   corev1.ConditionStatus
+		wantReason   string
+	}{
+		"checkpoint requested": {
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionTrue,
+			wantReason:  mpiJobCheckpointRequestedReason,
+		},
+		"within the grace period": {
+			requested:   true,
+			waited:      30 * time.Second,
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionTrue,
+			wantReason:  mpiJobCheckpointRequestedReason,
+		},
+		"checkpointed": {
+			requested:    true,
+			checkpointed: true,
+			waited:       30 * time.Second,
+			wantStatus:   corev1.ConditionFalse,
+			wantReason:   mpiJobCheckpointedReason,
+		},
+		"grace period expired": {
+			requested:  true,
+			waited:     time.Minute,
+			wantStatus: corev1.ConditionFalse,
+			wantReason: mpiJobCheckpointTimeoutReason,
+		},
+		"resumed": {
+			resumed:     true,
+			requested:   true,
+			waited:      30 * time.Second,
+			wantWaiting: true,
+			wantStatus:  corev1.ConditionFalse,
+			wantReason:  mpiJobResumedReason,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			f := newFixture(t, "")
+
+			// The launcher and the 2 workers are running, with a grace
+			// period of a minute to checkpoint.
+			startTime := metav1.Now()
+			mpiJob := newGroupJob("test", ptr.To[int32](2), &startTime, nil)
+			mpiJob.Spec.RunPolicy.Suspend = ptr.To(!tc.resumed)
+			mpiJob.Spec.RunPolicy.SuspendGracePeriodSeconds = ptr.To[int32](60)
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			requestTime := metav1.NewTime(fakeClock.Now().Add(-tc.waited))
+			if tc.requested {
+				cond := newCondition(kubeflow.JobSuspending, corev1.ConditionTrue, mpiJobCheckpointRequestedReason, "")
+				cond.LastTransitionTime = requestTime
+				setCondition(&mpiJob.Status, cond)
+			}
+			f.setUpGroupJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			fmjc := f.newFakeGroupJobController()
+			launcher := fmjc.newLauncherJob(mpiJobCopy)
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Status.Phase = corev1.PodRunning
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+			pods := []*corev1.Pod{launcherPod}
+			for i := 0; i < 2; i++ {
+				worker := fmjc.newWorker(mpiJobCopy, i)
+				worker.Status.Phase = corev1.PodRunning
+				if tc.requested {
+					worker.Annotations[kubeflow.SuspendRequestedAnnotation] = requestTime.UTC().Format(time.RFC3339)
+				}
+				if tc.checkpointed && i == 1 {
+					worker.Annotations[kubeflow.CheckpointedAnnotation] = "true"
+				}
+				f.setUpPod(worker)
+				pods = append(pods, worker)
+			}
+
+			c, _, _ := f.newController(fakeClock)
+			waiting, err := c.waitForCheckpoint(mpiJobCopy, f.jobLister[0])
+			if err != nil {
+				t.Fatalf("Waiting for the checkpoint: %v", err)
+			}
+			if waiting != tc.wantWaiting {
+				t.Errorf("Got waiting %t, want %t", waiting, tc.wantWaiting)
+			}
+			got := getCondition(mpiJobCopy.Status, kubeflow.JobSuspending)
+			if got.Status != tc.wantStatus || got.Reason != tc.wantReason {
+				t.Errorf("Got Suspending condition %s with reason %s, want %s with reason %s", got.Status, got.Reason, tc.wantStatus, tc.wantReason)
+			}
+			if !tc.requested {
+				// The running pods are annotated, and kept while they checkpoint.
+				for _, pod := range pods {
+					pod, err := f.kubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatalf("Getting pod: %v", err)
+					}
+					if got, want := pod.Annotations[kubeflow.SuspendRequestedAnnotation], fakeClock.Now().UTC().Format(time.RFC3339); got != want {
+						t.Errorf("Pod %s has the suspension requested at %q, want %q", pod.Name, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFinishedCleanupPolicy(t *testing.T) {
+	cases := map[string]struct {
+		cleanPodPolicy kubeflow.CleanPodPolicy
+		cleanupPolicy  *kubeflow.CleanupPolicy
+		wantWorkers    kubeflow.CleanPodPolicy
+		wantLauncher   kubeflow.CleanPodPolicy
+		wantResources  kubeflow.CleanPodPolicy
+	}{
+		"clean pod policy none": {
+			cleanPodPolicy: kubeflow.CleanPodPolicyNone,
+			wantWorkers:    kubeflow.CleanPodPolicyNone,
+			wantLauncher:   kubeflow.CleanPodPolicyNone,
+			wantResources:  kubeflow.CleanPodPolicyNone,
+		},
+		"clean pod policy all": {
+			cleanPodPolicy: kubeflow.CleanPodPolicyAll,
+			wantWorkers:    kubeflow.CleanPodPolicyAll,
+			wantLauncher:   kubeflow.CleanPodPolicyRunning,
+			wantResources:  kubeflow.CleanPodPolicyNone,
+		},
+		"cleanup policy overrides": {
+			cleanPodPolicy: kubeflow.CleanPodPolicyAll,
+			cleanupPolicy: &kubeflow.CleanupPolicy{
+				Workers:   ptr.To(kubeflow.CleanPodPolicyRunning),
+				Launcher:  ptr.To(kubeflow.CleanPodPolicyNone),
+				Resources: ptr.To(kubeflow.CleanPodPolicyAll),
 			},
+			wantWorkers:   kubeflow.CleanPodPolicyRunning,
+			wantLauncher:  kubeflow.CleanPodPolicyNone,
+			wantResources: kubeflow.CleanPodPolicyAll,
+		},
+		"partial cleanup policy": {
+			cleanPodPolicy: kubeflow.CleanPodPolicyRunning,
+			cleanupPolicy: &kubeflow.CleanupPolicy{
+				Launcher: ptr.To(kubeflow.CleanPodPolicyAll),
+			},
+			wantWorkers:   kubeflow.CleanPodPolicyRunning,
+			wantLauncher:  kubeflow.CleanPodPolicyAll,
+			wantResources: kubeflow.CleanPodPolicyNone,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := &kubeflow.GroupJob{
+				Spec: kubeflow.GroupJobSpec{
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: &tc.cleanPodPolicy,
+						CleanupPolicy:  tc.cleanupPolicy,
+					},
+				},
+			}
+			workers, launcher, resources := finishedCleanupPolicy(mpiJob)
+			if workers != tc.wantWorkers || launcher != tc.wantLauncher || resources != tc.wantResources {
+				t.Errorf("Got policies (%s, %s, %s), want (%s, %s, %s)", workers, launcher, resources, tc.wantWorkers, tc.wantLauncher, tc.wantResources)
+			}
+		})
+	}
+}
+
+func TestCleanUpFinishedLauncherAndResources(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.CleanupPolicy = &kubeflow.CleanupPolicy{
+		Workers:   ptr.To(kubeflow.CleanPodPolicyNone),
+		Launcher:  ptr.To(kubeflow.CleanPodPolicyAll),
+		Resources: ptr.To(kubeflow.CleanPodPolicyAll),
+	}
+	msg := fmt.Sprintf("GroupJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:   batchv1.JobComplete,
+		Status: corev1.ConditionTrue,
+	})
+	f.setUpLauncher(launcher)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodSucceeded
+	f.setUpPod(launcherPod)
+
+	// The workers are kept.
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodSucceeded
+		f.setUpPod(worker)
+	}
+
+	f.setUpService(newJobService(mpiJobCopy))
+	f.setUpConfigMap(newConfigMap(mpiJobCopy, replicas))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	f.kubeActions = append(f.kubeActions,
+		core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, launcherPod.Name),
+		core.NewDeleteAction(schema.GroupVersionResource{Resource: "services"}, mpiJob.Namespace, mpiJob.Name),
+		core.NewDeleteAction(schema.GroupVersionResource{Resource: "configmaps"}, mpiJob.Namespace, mpiJob.Name+configSuffix),
+		core.NewDeleteAction(schema.GroupVersionResource{Resource: "secrets"}, mpiJob.Namespace, mpiJob.Name+sshAuthSecretSuffix),
+	)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestActiveDeadlineExceeded(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+	f := newFixture(t, "")
+
+	var replicas int32 = 2
+	startTime := metav1.NewTime(fakeClock.Now().Add(-2 * time.Minute))
+	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
+	mpiJob.Spec.RunPolicy.ActiveDeadlineSeconds = ptr.To[int64](60)
+	mpiJob.Spec.RunPolicy.CleanPodPolicy = ptr.To(kubeflow.CleanPodPolicyRunning)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	f.setUpLauncher(launcher)
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		f.setUpPod(worker)
+		f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, worker.Name))
+	}
+	f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "jobs"}, mpiJob.Namespace, launcher.Name))
+
+	mpiJobCopy.Status.CompletionTime = &metav1.Time{Time: fakeClock.Now()}
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
+	}
+	msg = fmt.Sprintf("GroupJob %s/%s was active for longer than its deadline of %v", mpiJob.Namespace, mpiJob.Name, time.Minute)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobDeadlineExceededReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.runWithClock(getKey(mpiJob, t), fakeClock)
+}
+
+func TestActiveDeadlineExcludesSuspension(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+	f := newFixture(t, "")
+
+	// The GroupJob started long ago, but it was suspended since and is
+	// resumed now.
+	var replicas int32 = 2
+	startTime := metav1.NewTime(fakeClock.Now().Add(-2 * time.Minute))
+	mpiJob := newGroupJob("test", &replicas, &startTime, nil)
+	mpiJob.Spec.RunPolicy.ActiveDeadlineSeconds = ptr.To[int64](60)
+	msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "GroupJob suspended")
+	c, _, _ := f.newController(fakeClock)
+
+	if failed, err := c.checkActiveDeadline(mpiJob); failed || err != nil {
+		t.Errorf("Resumed GroupJob failed the deadline check: %t, %v", failed, err)
+	}
+
+	// Once its status is updated, the start time is reset.
+	updateGroupJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionFalse, mpiJobResumedReason, "GroupJob resumed")
+	mpiJob.Status.StartTime = &metav1.Time{Time: fakeClock.Now()}
+	if failed, err := c.checkActiveDeadline(mpiJob); failed || err != nil {
+		t.Errorf("Resumed GroupJob failed the deadline check: %t, %v", failed, err)
+	}
+
+	fakeClock.Step(time.Minute)
+	if failed, err := c.checkActiveDeadline(mpiJob); !failed || err != nil {
+		t.Errorf("GroupJob past its deadline passed the deadline check: %t, %v", failed, err)
+	}
+	if !isFailed(mpiJob.Status) {
+		t.Error("GroupJob past its deadline didn't fail")
+	}
+}
+
+func TestDebugHoldFailedGroupJob(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	// The workers would be cleaned up under the CleanPodPolicy All.
+	var replicas int32 = 2
+	mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.DebugHoldSeconds = ptr.To[int32](600)
+	failedMsg := fmt.Sprintf("GroupJob %s/%s has failed", mpiJob.Namespace, mpiJob.Name)
+	updateGroupJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobFailedReason, failedMsg)
+	f.setUpGroupJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	fmjc := f.newFakeGroupJobController()
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:   batchv1.JobFailed,
+		Status: corev1.ConditionTrue,
+	})
+	f.setUpLauncher(launcher)
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		f.setUpPod(worker)
+	}
+
+	msg := fmt.Sprintf("Holding the pods of GroupJob %s/%s for debugging until %s: %s", mpiJob.Namespace, mpiJob.Name, fakeClock.Now().Add(10*time.Minute).UTC().Format(time.RFC3339), failedMsg)
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobDebugHold, corev1.ConditionTrue, mpiJobDebugHoldReason, msg)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.runWithClock(getKey(mpiJob, t), fakeClock)
+}
+
+func TestHoldForDebugging(t *testing.T) {
+	cases := map[string]struct {
+		debugHoldSeconds *int32
+		annotation       *string
+		condStatus       corev1.ConditionStatus
+		held             time.Duration
+		wantHeld         bool
+		wantStatus       corev1.ConditionStatus
+		wantReason       string
+	}{
+		"within the hold": {
+			debugHoldSeconds: ptr.To[int32](600),
+			condStatus:       corev1.ConditionTrue,
+			held:             5 * time.Minute,
+			wantHeld:         true,
+			wantStatus:       corev1.ConditionTrue,
+			wantReason:       mpiJobDebugHoldReason,
+		},
+		"hold expired": {
+			debugHoldSeconds: ptr.To[int32](600),
+			condStatus:       corev1.ConditionTrue,
+			held:             10 * time.Minute,
+			wantHeld:         true,
+			wantStatus:       corev1.ConditionFalse,
+			wantReason:       mpiJobDebugHoldExpiredReason,
+		},
+		"annotation extends the hold": {
+			debugHoldSeconds: ptr.To[int32](600),
+			annotation:       ptr.To("1h"),
+			condStatus:       corev1.ConditionTrue,
+			held:             30 * time.Minute,
+			wantHeld:         true,
+			wantStatus:       corev1.ConditionTrue,
+			wantReason:       mpiJobDebugHoldReason,
+		},
+		"annotation removed": {
+			condStatus: corev1.ConditionTrue,
+			held:       5 * time.Minute,
+			wantHeld:   true,
+			wantStatus: corev1.ConditionFalse,
+			wantReason: mpiJobDebugHoldReleasedReason,
+		},
+		"hold already released": {
+			debugHoldSeconds: ptr.To[int32](600),
+			condStatus:       corev1.ConditionFalse,
+			held:             5 * time.Minute,
+			wantStatus:       corev1.ConditionFalse,
+			wantReason:       mpiJobDebugHoldReleasedReason,
 		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			job := tc.job.DeepCopy()
-			scheme.Scheme.Default(job)
-			ctrl := &GroupJobController{}
-			launcher := ctrl.newLauncherJob(job)
-			if !metav1.IsControlledBy(launcher, job) {
-				t.Errorf("Created launcher Pod is not controlled by Job")
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			f := newFixture(t, "")
+
+			startTime := metav1.Now()
+			mpiJob := newGroupJob("test", ptr.To[int32](2), &startTime, &startTime)
+			mpiJob.Spec.RunPolicy.DebugHoldSeconds = tc.debugHoldSeconds
+			if tc.annotation != nil {
+				mpiJob.Annotations = map[string]string{kubeflow.DebugHoldAnnotation: *tc.annotation}
 			}
-			if diff := cmp.Diff(&tc.wantLauncher, launcher, ignoreReferences); diff != "" {
-				t.Errorf("Unexpected launcher pod (-want,+got):\n%s", diff)
+			reason := mpiJobDebugHoldReason
+			if tc.condStatus != corev1.ConditionTrue {
+				reason = mpiJobDebugHoldReleasedReason
 			}
-			worker := ctrl.newWorker(job, tc.workerIndex)
-			if !metav1.IsControlledBy(worker, job) {
-				t.Errorf("Created worker Pod is not controlled by Job")
+			cond := newCondition(kubeflow.JobDebugHold, tc.condStatus, reason, "")
+			cond.LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-tc.held))
+			setCondition(&mpiJob.Status, cond)
+			f.setUpGroupJob(mpiJob)
+			c, _, _ := f.newController(fakeClock)
+
+			held, err := c.holdForDebugging(mpiJob, "launcher Job test-launcher failed")
+			if err != nil {
+				t.Fatalf("Holding for debugging: %v", err)
 			}
-			if diff := cmp.Diff(&tc.wantWorker, worker, ignoreReferences); diff != "" {
-				t.Errorf("Unexpected launcher pod (-want,+got):\n%s", diff)
+			if held != tc.wantHeld {
+				t.Errorf("Got held %t, want %t", held, tc.wantHeld)
+			}
+			got := getCondition(mpiJob.Status, kubeflow.JobDebugHold)
+			if got.Status != tc.wantStatus || got.Reason != tc.wantReason {
+				t.Errorf("Got DebugHold condition %s with reason %s, want %s with reason %s", got.Status, got.Reason, tc.wantStatus, tc.wantReason)
 			}
 		})
 	}
 }
 
-func TestNewConfigMap(t *testing.T) {
-	testCases := map[string]struct {
-		mpiJob         *kubeflow.GroupJob
-		workerReplicas int32
-		wantCM         *corev1.ConfigMap
+func TestDebugHoldFailedWorker(t *testing.T) {
+	cases := map[string]struct {
+		heldFor    *time.Duration
+		wantDelete bool
 	}{
-		"OpenMPI without slots, enable launcher as worker": {
-			mpiJob: &kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "openmpi-without-slots",
-					Namespace: "tenant-a",
-				},
-				Spec: kubeflow.GroupJobSpec{
-					MPIImplementation:   kubeflow.MPIImplementationOpenMPI,
-					RunLauncherAsWorker: ptr.To(true),
-				},
-			},
-			workerReplicas: 2,
-			wantCM: &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "openmpi-without-slots-config",
-					Namespace: "tenant-a",
-					Labels: map[string]string{
-						"app": "openmpi-without-slots",
-					},
-				},
-				Data: map[string]string{
-					"hostfile": "openmpi-without-slots-launcher.openmpi-without-slots.tenant-a.svc slots=1\nopenmpi-without-slots-worker-0.openmpi-without-slots.tenant-a.svc slots=1\nopenmpi-without-slots-worker-1.openmpi-without-slots.tenant-a.svc slots=1\n",
-				},
-			},
+		"hold starts": {},
+		"hold expired": {
+			heldFor:    ptr.To(10 * time.Minute),
+			wantDelete: true,
 		},
-		"OpenMPI without slots, zero explicit workers": {
-			mpiJob: &kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "openmpi-without-slots",
-					Namespace: "tenant-a",
-				},
-				Spec: kubeflow.GroupJobSpec{
-					MPIImplementation:   kubeflow.MPIImplementationOpenMPI,
-					RunLauncherAsWorker: ptr.To(true),
-				},
-			},
-			workerReplicas: 0,
-			wantCM: &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "openmpi-without-slots-config",
-					Namespace: "tenant-a",
-					Labels: map[string]string{
-						"app": "openmpi-without-slots",
-					},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			f := newFixture(t, "")
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			var replicas int32 = 2
+			mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].RestartPolicy = kubeflow.RestartPolicyExitCode
+			mpiJob.Spec.RunPolicy.DebugHoldSeconds = ptr.To[int32](600)
+			msg := fmt.Sprintf("GroupJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+			if tc.heldFor != nil {
+				cond := newCondition(kubeflow.JobDebugHold, corev1.ConditionTrue, mpiJobDebugHoldReason, "")
+				cond.LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-*tc.heldFor))
+				setCondition(&mpiJob.Status, cond)
+				// The restart was counted once the hold expired.
+				mpiJob.Status.RestartCount = 1
+				mpiJob.Status.LastRestartTime = ptr.To(metav1.NewTime(fakeClock.Now().Add(-time.Minute)))
+			}
+			f.setUpGroupJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			f.setUpService(newJobService(mpiJobCopy))
+			secret, err := newSSHAuthSecret(mpiJobCopy)
+			if err != nil {
+				t.Fatalf("Creating SSH auth secret: %v", err)
+			}
+			f.setUpSecret(secret)
+
+			fmjc := f.newFakeGroupJobController()
+			launcher := fmjc.newLauncherJob(mpiJobCopy)
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Status.Phase = corev1.PodRunning
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+
+			var runningPodList []*corev1.Pod
+			for i := 0; i < int(replicas); i++ {
+				worker := fmjc.newWorker(mpiJobCopy, i)
+				if i == 0 {
+					worker.Status.Phase = corev1.PodFailed
+					worker.Status.ContainerStatuses = []corev1.ContainerStatus{{
+						Name: "foo",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{ExitCode: 137},
+						},
+					}}
+				} else {
+					worker.Status.Phase = corev1.PodRunning
+					runningPodList = append(runningPodList, worker)
+				}
+				f.setUpPod(worker)
+			}
+			configMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+			f.setUpConfigMap(configMap)
+
+			if tc.wantDelete {
+				f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "pods"}, mpiJob.Namespace, workerName(mpiJob, 0)))
+				// The next failure gets a new debug hold.
+				mpiJobCopy.Status.Conditions = filterOutCondition(mpiJobCopy.Status.Conditions, kubeflow.JobDebugHold)
+			} else {
+				cause := fmt.Sprintf("Restarting worker pod %s: container %q terminated with retryable exit code %d", workerName(mpiJob, 0), "foo", 137)
+				msg := fmt.Sprintf("Holding the pods of GroupJob %s/%s for debugging until %s: %s", mpiJob.Namespace, mpiJob.Name, fakeClock.Now().Add(10*time.Minute).UTC().Format(time.RFC3339), cause)
+				updateGroupJobConditions(mpiJobCopy, kubeflow.JobDebugHold, corev1.ConditionTrue, mpiJobDebugHoldReason, msg)
+			}
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Active: 1,
 				},
-				Data: map[string]string{
-					"hostfile": "openmpi-without-slots-launcher.openmpi-without-slots.tenant-a.svc slots=1\n",
+				kubeflow.MPIReplicaTypeWorker: {
+					Selector:      workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+					Active:        1,
+					Failed:        1,
+					FailedByCause: map[kubeflow.FailureCause]int32{kubeflow.FailureCauseError: 1},
 				},
-			},
+			}
+			setUpGroupJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+			updateGroupJobConditions(mpiJobCopy, kubeflow.JobRestarting, corev1.ConditionTrue, mpiJobRestartingReason, "1/2 workers are restarting after a retryable exit code")
+			f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+			f.runWithClock(getKey(mpiJob, t), fakeClock)
+		})
+	}
+}
+
+func TestTTLAfterFinished(t *testing.T) {
+	cases := map[string]struct {
+		finishedAgo time.Duration
+		wantDelete  bool
+	}{
+		"TTL not expired": {
+			finishedAgo: 30 * time.Second,
 		},
-		"OpenMPI without slots, disable launcher as worker": {
-			mpiJob: &kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "openmpi-without-slots",
-					Namespace: "tenant-a",
-				},
-				Spec: kubeflow.GroupJobSpec{
-					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
-				},
-			},
-			workerReplicas: 2,
-			wantCM: &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "openmpi-without-slots-config",
-					Namespace: "tenant-a",
-					Labels: map[string]string{
-						"app": "openmpi-without-slots",
-					},
-				},
-				Data: map[string]string{
-					"hostfile": "openmpi-without-slots-worker-0.openmpi-without-slots.tenant-a.svc slots=1\nopenmpi-without-slots-worker-1.openmpi-without-slots.tenant-a.svc slots=1\n",
-				},
-			},
+		"TTL expired": {
+			finishedAgo: 2 * time.Minute,
+			wantDelete:  true,
 		},
-		"IntelMPI with slots": {
-			mpiJob: &kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "intelmpi-with-slots",
-					Namespace: "project-x",
-				},
-				Spec: kubeflow.GroupJobSpec{
-					SlotsPerWorker:    ptr.To[int32](10),
-					MPIImplementation: kubeflow.MPIImplementationIntel,
-				},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+			f := newFixture(t, "")
+
+			var replicas int32 = 2
+			startTime := metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+			completionTime := metav1.NewTime(fakeClock.Now().Add(-tc.finishedAgo))
+			mpiJob := newGroupJob("test", &replicas, &startTime, &completionTime)
+			mpiJob.Spec.RunPolicy.TTLSecondsAfterFinished = ptr.To[int32](60)
+			mpiJob.Spec.RunPolicy.CleanPodPolicy = ptr.To(kubeflow.CleanPodPolicyNone)
+			msg := fmt.Sprintf("GroupJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
+			updateGroupJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
+			f.setUpGroupJob(mpiJob)
+
+			if tc.wantDelete {
+				f.actions = append(f.actions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "groupjobs"}, mpiJob.Namespace, mpiJob.Name))
+			}
+
+			f.runWithClock(getKey(mpiJob, t), fakeClock)
+		})
+	}
+}
+
+func TestRecordNodeFailures(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	failedWorker := func(name, node, reason string, finishedAt time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{
+				Phase:  corev1.PodFailed,
+				Reason: reason,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode:   1,
+							FinishedAt: metav1.NewTime(finishedAt),
+						},
+					},
+				}},
 			},
-			workerReplicas: 1,
-			wantCM: &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "intelmpi-with-slots-config",
-					Namespace: "project-x",
-					Labels: map[string]string{
-						"app": "intelmpi-with-slots",
+		}
+	}
+	mpiJob := newGroupJob("test", ptr.To[int32](4), nil, nil)
+	mpiJob.Spec.RunPolicy.NodeFailureLimit = ptr.To[int32](2)
+	recorder := record.NewFakeRecorder(10)
+	c := &GroupJobController{recorder: recorder}
+
+	// The workers of the first attempt: two of them were evicted together
+	// from node-a, another one lost node-b, and the application failed on
+	// node-c, which doesn't count against the node.
+	nodes := recordNodeFailures(mpiJob, []*corev1.Pod{
+		failedWorker("test-worker-0", "node-a", podReasonEvicted, now),
+		failedWorker("test-worker-1", "node-a", podReasonEvicted, now),
+		failedWorker("test-worker-2", "node-b", podReasonNodeLost, now.Add(-time.Second)),
+		failedWorker("test-worker-3", "node-c", "", now),
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-4"}, Spec: corev1.PodSpec{NodeName: "node-d"}},
+	})
+	if diff := cmp.Diff([]string{"node-b", "node-a"}, nodes); diff != "" {
+		t.Errorf("Unexpected recorded nodes (-want,+got):\n%s", diff)
+	}
+	c.reportNodeFailures(mpiJob, nodes)
+	// The same failures observed again, and a worker of the next attempt.
+	nodes = recordNodeFailures(mpiJob, []*corev1.Pod{
+		failedWorker("test-worker-0", "node-a", podReasonEvicted, now),
+		failedWorker("test-worker-1", "node-a", podReasonEvicted, now.Add(time.Minute)),
+		failedWorker("test-worker-2", "node-b", podReasonNodeLost, now.Add(-time.Second)),
+	})
+	if diff := cmp.Diff([]string{"node-a"}, nodes); diff != "" {
+		t.Errorf("Unexpected recorded nodes (-want,+got):\n%s", diff)
+	}
+	c.reportNodeFailures(mpiJob, nodes)
+
+	want := []kubeflow.NodeFailure{
+		{NodeName: "node-b", Failures: 1, LastFailureTime: metav1.NewTime(now.Add(-time.Second))},
+		{NodeName: "node-a", Failures: 2, LastFailureTime: metav1.NewTime(now.Add(time.Minute))},
+	}
+	if diff := cmp.Diff(want, mpiJob.Status.NodeFailures); diff != "" {
+		t.Errorf("Unexpected node failures (-want,+got):\n%s", diff)
+	}
+	if got := len(recorder.Events); got != 1 {
+		t.Errorf("Got %d events, want 1", got)
+	}
+	if diff := cmp.Diff([]string{"node-a"}, avoidedNodes(mpiJob)); diff != "" {
+		t.Errorf("Unexpected avoided nodes (-want,+got):\n%s", diff)
+	}
+}
+
+func TestAvoidFailedNodes(t *testing.T) {
+	avoid := corev1.NodeSelectorRequirement{
+		Key:      metav1.ObjectNameField,
+		Operator: corev1.NodeSelectorOpNotIn,
+		Values:   []string{"node-a"},
+	}
+	gpuNodes := corev1.NodeSelectorRequirement{
+		Key:      "example.com/gpu",
+		Operator: corev1.NodeSelectorOpExists,
+	}
+	cases := map[string]struct {
+		limit    *int32
+		affinity *corev1.Affinity
+		want     *corev1.Affinity
+	}{
+		"no limit": {},
+		"no affinity": {
+			limit: ptr.To[int32](2),
+			want: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchFields: []corev1.NodeSelectorRequirement{avoid},
+						}},
 					},
 				},
-				Data: map[string]string{
-					"hostfile": "intelmpi-with-slots-worker-0.intelmpi-with-slots.project-x.svc:10\n",
-				},
 			},
 		},
-		"MPICH with slots": {
-			mpiJob: &kubeflow.GroupJob{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "mpich-with-slots",
-					Namespace: "project-x",
-				},
-				Spec: kubeflow.GroupJobSpec{
-					SlotsPerWorker:    ptr.To[int32](10),
-					MPIImplementation: kubeflow.MPIImplementationMPICH,
+		"node affinity of the template": {
+			limit: ptr.To[int32](2),
+			affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{MatchExpressions: []corev1.NodeSelectorRequirement{gpuNodes}},
+							{MatchFields: []corev1.NodeSelectorRequirement{{
+								Key:      metav1.ObjectNameField,
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"node-a", "node-b"},
+							}}},
+						},
+					},
 				},
 			},
-			workerReplicas: 1,
-			wantCM: &corev1.ConfigMap{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "mpich-with-slots-config",
-					Namespace: "project-x",
-					Labels: map[string]string{
-						"app": "mpich-with-slots",
+			want: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{gpuNodes},
+								MatchFields:      []corev1.NodeSelectorRequirement{avoid},
+							},
+							{MatchFields: []corev1.NodeSelectorRequirement{
+								{
+									Key:      metav1.ObjectNameField,
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{"node-a", "node-b"},
+								},
+								avoid,
+							}},
+						},
 					},
 				},
-				Data: map[string]string{
-					"hostfile": "mpich-with-slots-worker-0.mpich-with-slots.project-x.svc:10\n",
-				},
 			},
 		},
+		"below the limit": {
+			limit: ptr.To[int32](3),
+		},
 	}
-	for name, tc := range testCases {
+	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			cm := newConfigMap(tc.mpiJob, tc.workerReplicas)
-			if !metav1.IsControlledBy(cm, tc.mpiJob) {
-				t.Errorf("Created configMap is not controlled by GroupJob")
+			f := newFixture(t, "")
+			mpiJob := newGroupJob("test", ptr.To[int32](1), nil, nil)
+			mpiJob.Spec.RunPolicy.NodeFailureLimit = tc.limit
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Affinity = tc.affinity
+			mpiJob.Status.NodeFailures = []kubeflow.NodeFailure{
+				{NodeName: "node-a", Failures: 2},
+				{NodeName: "node-b", Failures: 1},
 			}
-			if diff := cmp.Diff(tc.wantCM, cm, ignoreReferences); len(diff) != 0 {
-				t.Errorf("Unexpected configMap (-want,+got):\n%s", diff)
+			c := f.newFakeGroupJobController()
+			if diff := cmp.Diff(tc.want, c.newWorker(mpiJob, 0).Spec.Affinity); diff != "" {
+				t.Errorf("Unexpected affinity of the worker (-want,+got):\n%s", diff)
 			}
 		})
 	}
 }
-
-func joinEnvVars(evs ...interface{}) []corev1.EnvVar {
-	var result []corev1.EnvVar
-	for _, ev := range evs {
-		switch v := ev.(type) {
-		case corev1.EnvVar:
-			result = append(result, v)
-		case []corev1.EnvVar:
-			result = append(result, v...)
-		default:
-			panic("must by of type EnvVar or []EnvVar")
-		}
-	}
-	return result
-}
-
-func mockJobPod(job *batchv1.Job) *corev1.Pod {
-	job.Spec.Selector = &metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			"controller-uid": string(uuid.NewUUID()),
-		},
-	}
-	return &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      job.Name + "-" + rand.String(5),
-			Labels:    job.Spec.Selector.MatchLabels,
-			Namespace: job.Namespace,
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
-			},
-		},
-	}
-}
-
-func (f *fixture) newFakeGroupJobController() *GroupJobController {
-	kubeClient := k8sfake.NewSimpleClientset(f.kubeObjects...)
-
-	k8sI := kubeinformers.NewSharedInformerFactory(kubeClient, noResyncPeriodFunc())
-	return &GroupJobController{
-		recorder:  &record.FakeRecorder{},
-		podLister: k8sI.Core().V1().Pods().Lister(),
-	}
-}
@@ -0,0 +1,136 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// topologyAffinityWeight is the weight of the preferred pod affinity packing
+// the workers in a topology domain. It is the highest, as the pods already
+// have the scheduling constraints of their template.
+const topologyAffinityWeight = 100
+
+// topologyKey returns the node label defining the topology domains of mpiJob,
+// or an empty string if the placement ignores the topology.
+func topologyKey(mpiJob *kubeflow.GroupJob) string {
+	if schedPolicy := mpiJob.Spec.RunPolicy.SchedulingPolicy; schedPolicy != nil {
+		return schedPolicy.TopologyKey
+	}
+	return ""
+}
+
+// setTopologyAffinity makes the pod prefer the topology domain of the workers
+// of mpiJob already scheduled. The affinity is a preference: a GroupJob
+// larger than a domain spans several of them instead of staying pending.
+func setTopologyAffinity(podSpec *corev1.PodSpec, mpiJob *kubeflow.GroupJob) {
+	key := topologyKey(mpiJob)
+	if len(key) == 0 {
+		return
+	}
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.PodAffinity == nil {
+		podSpec.Affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	podAffinity := podSpec.Affinity.PodAffinity
+	podAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		corev1.WeightedPodAffinityTerm{
+			Weight: topologyAffinityWeight,
+			PodAffinityTerm: corev1.PodAffinityTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: defaultLabels(mpiJob.Name, worker),
+				},
+				TopologyKey: key,
+			},
+		})
+}
+
+// hostLocation is where a worker runs: the value of the topology label of its
+// node, and the node.
+type hostLocation struct {
+	domain string
+	node   string
+}
+
+// hostTopology maps the names of the workers to their location.
+type hostTopology map[string]hostLocation
+
+// workerTopology returns the location of the given workers. Workers on nodes
+// that no longer exist are left out.
+func (c *GroupJobController) workerTopology(mpiJob *kubeflow.GroupJob, pods []*corev1.Pod) (hostTopology, error) {
+	key := topologyKey(mpiJob)
+	topology := make(hostTopology, len(pods))
+	for _, p := range pods {
+		if len(p.Spec.NodeName) == 0 {
+			continue
+		}
+		node, err := c.nodeLister.Get(p.Spec.NodeName)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		topology[p.Name] = hostLocation{domain: node.Labels[key], node: node.Name}
+	}
+	return topology, nil
+}
+
+// before reports whether host a is listed before host b: hosts are grouped by
+// domain, then by node. Hosts on nodes without the topology label come after
+// the others, followed by the hosts with an unknown location.
+func (t hostTopology) before(a, b string) bool {
+	locA, okA := t[a]
+	locB, okB := t[b]
+	if okA != okB || !okA {
+		return okA && !okB
+	}
+	if locA.domain != locB.domain {
+		if len(locA.domain) == 0 || len(locB.domain) == 0 {
+			return len(locB.domain) == 0
+		}
+		return locA.domain < locB.domain
+	}
+	return locA.node < locB.node
+}
+
+// orderHostsByTopology lists the workers in the hostfile and `discover_hosts.sh`
+// of the ConfigMap in the order of their location, keeping their order within
+// a node. The launcher, when it runs as a worker, stays first.
+func orderHostsByTopology(configMap *corev1.ConfigMap, mpiJob *kubeflow.GroupJob, runningPods []*corev1.Pod, topology hostTopology) {
+	var workers []hostfileEntry
+	if isElastic(mpiJob) {
+		workers = runningHostfileWorkers(mpiJob, runningPods)
+	} else {
+		workers = hostfileWorkers(mpiJob, workerReplicas(mpiJob))
+	}
+	sort.SliceStable(workers, func(i, j int) bool {
+		return topology.before(workers[i].name, workers[j].name)
+	})
+	configMap.Data[hostfileName] = newHostfile(mpiJob, workers)
+
+	sort.SliceStable(runningPods, func(i, j int) bool {
+		return topology.before(runningPods[i].Name, runningPods[j].Name)
+	})
+	configMap.Data[discoverHostsScriptName] = newDiscoverHostsScript(mpiJob, runningPods)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// checkTTLAfterFinished deletes the finished mpiJob once its
+// ttlSecondsAfterFinished passed since its completion time, and returns true
+// if it did. The garbage collector then deletes the objects it owns: the
+// launcher Job, the workers, the ConfigMap, the Service and the Secret.
+func (c *GroupJobController) checkTTLAfterFinished(mpiJob *kubeflow.GroupJob) (bool, error) {
+	seconds := mpiJob.Spec.RunPolicy.TTLSecondsAfterFinished
+	if seconds == nil || mpiJob.Status.CompletionTime == nil {
+		return false, nil
+	}
+	ttl := time.Duration(*seconds) * time.Second
+	if finished := c.clock.Since(mpiJob.Status.CompletionTime.Time); finished < ttl {
+		c.enqueueAfter(mpiJob, ttl-finished)
+		return false, nil
+	}
+
+	klog.Infof("Deleting GroupJob %s/%s, finished for longer than its TTL of %v", mpiJob.Namespace, mpiJob.Name, ttl)
+	err := c.kubeflowClient.KubeflowV2beta1().GroupJobs(mpiJob.Namespace).Delete(context.TODO(), mpiJob.Name, metav1.DeleteOptions{
+		// The GroupJob could have been recreated with the same name.
+		Preconditions:     &metav1.Preconditions{UID: &mpiJob.UID},
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("deleting GroupJob after its TTL: %w", err)
+	}
+	return true, nil
+}
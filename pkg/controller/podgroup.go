@@ -21,7 +21,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	schedulinglisters "k8s.io/client-go/listers/scheduling/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog"
@@ -56,12 +58,58 @@ type PodGroupControl interface {
 	updatePodGroup(ctx context.Context, old, new metav1.Object) (metav1.Object, error)
 	// deletePodGroup will delete a podGroup.
 	deletePodGroup(ctx context.Context, namespace, name string) error
-	// decoratePodTemplateSpec will decorate the podTemplate before it's used to generate a pod with information for gang-scheduling.
-	decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJobName string)
+	// decoratePodTemplateSpec will decorate the podTemplate of the replica type before it's used to generate a pod with information for gang-scheduling.
+	decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType)
 	// calculatePGMinResources will calculate minResources for podGroup.
 	calculatePGMinResources(minMember *int32, mpiJob *kubeflow.GroupJob) *corev1.ResourceList
 	// pgSpecsAreEqual will return true if the spec fields of two podGroup are equals.
 	pgSpecsAreEqual(a, b metav1.Object) bool
+	// podGroupPhase will return the phase of a podGroup, or of the pods for gang-schedulers without a podGroup API,
+	// along with the reason the scheduler gave for not scheduling it, if any.
+	podGroupPhase(pg metav1.Object, pods []*corev1.Pod) (kubeflow.PodGroupPhase, string)
+}
+
+// podGroupControlConfig holds what the PodGroupControl of a gang-scheduler is
+// built from.
+type podGroupControlConfig struct {
+	volcanoClient       volcanoclient.Interface
+	schedClient         schedclientset.Interface
+	namespace           string
+	schedulerName       string
+	priorityClassLister schedulinglisters.PriorityClassLister
+}
+
+// podGroupControls registers the PodGroupControl of each gang-scheduler by
+// the name given to --gang-scheduling.
+var podGroupControls = map[string]func(cfg podGroupControlConfig) PodGroupControl{
+	options.GangSchedulerVolcano: func(cfg podGroupControlConfig) PodGroupControl {
+		return NewVolcanoCtrl(cfg.volcanoClient, cfg.namespace, cfg.priorityClassLister)
+	},
+	options.GangSchedulerSchedulerPlugins: newSchedulerPluginsCtrlFromConfig,
+	options.GangSchedulerYuniKorn: func(podGroupControlConfig) PodGroupControl {
+		return NewYuniKornCtrl()
+	},
+	options.GangSchedulerKueue: func(podGroupControlConfig) PodGroupControl {
+		return NewKueueCtrl()
+	},
+}
+
+// newPodGroupControl returns the PodGroupControl registered for the
+// gang-scheduler, or nil if gang-scheduling is disabled. Other names are taken
+// as the name of a scheduler running the coscheduling plugin of
+// scheduler-plugins.
+func newPodGroupControl(cfg podGroupControlConfig) PodGroupControl {
+	if len(cfg.schedulerName) == 0 {
+		return nil
+	}
+	if newCtrl, ok := podGroupControls[cfg.schedulerName]; ok {
+		return newCtrl(cfg)
+	}
+	return newSchedulerPluginsCtrlFromConfig(cfg)
+}
+
+func newSchedulerPluginsCtrlFromConfig(cfg podGroupControlConfig) PodGroupControl {
+	return NewSchedulerPluginsCtrl(cfg.schedClient, cfg.namespace, cfg.schedulerName, cfg.priorityClassLister)
 }
 
 // VolcanoCtrl is the implementation fo PodGroupControl with volcano.
@@ -105,7 +153,8 @@ func (v *VolcanoCtrl) StartInformerFactory(stopCh <-chan struct{}) {
 //	priorityClass: A value returned from the calcPriorityClassName function.
 //	minResources: nil
 //
-// However, it doesn't pass the ".schedulingPolicy.scheduleTimeoutSeconds" to the podGroup resource.
+// However, it doesn't pass the ".schedulingPolicy.scheduleTimeoutSeconds" to the podGroup resource,
+// which is only enforced by the controller.
 func (v *VolcanoCtrl) newPodGroup(mpiJob *kubeflow.GroupJob) metav1.Object {
 	if mpiJob == nil {
 		return nil
@@ -156,7 +205,7 @@ func (v *VolcanoCtrl) deletePodGroup(ctx context.Context, namespace, name string
 	return v.Client.SchedulingV1beta1().PodGroups(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
-func (v *VolcanoCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJobName string) {
+func (v *VolcanoCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJob *kubeflow.GroupJob, _ kubeflow.MPIReplicaType) {
 	if pts.Spec.SchedulerName != v.schedulerName {
 		klog.Warningf("%s scheduler is specified when gang-scheduling is enabled and it will be overwritten", pts.Spec.SchedulerName)
 	}
@@ -165,7 +214,7 @@ func (v *VolcanoCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJo
 		pts.Annotations = make(map[string]string)
 	}
 	// We create the podGroup with the same name as the mpiJob.
-	pts.Annotations[volcanov1beta1.KubeGroupNameAnnotationKey] = mpiJobName
+	pts.Annotations[volcanov1beta1.KubeGroupNameAnnotationKey] = mpiJob.Name
 }
 
 // calculatePGMinResources calculates minResources for volcano podGroup.
@@ -191,6 +240,31 @@ func (v *VolcanoCtrl) pgSpecsAreEqual(a, b metav1.Object) bool {
 	return equality.Semantic.DeepEqual(PGa.Spec, PGb.Spec)
 }
 
+// podGroupPhase maps the phase of the PodGroup. Volcano reports why it can't
+// allocate a PodGroup through its latest condition, of type Unschedulable.
+func (v *VolcanoCtrl) podGroupPhase(pg metav1.Object, _ []*corev1.Pod) (kubeflow.PodGroupPhase, string) {
+	status := pg.(*volcanov1beta1.PodGroup).Status
+	phase := kubeflow.PodGroupPending
+	switch status.Phase {
+	case volcanov1beta1.PodGroupInqueue:
+		phase = kubeflow.PodGroupInqueue
+	case volcanov1beta1.PodGroupRunning, volcanov1beta1.PodGroupCompleted:
+		return kubeflow.PodGroupRunning, ""
+	case volcanov1beta1.PodGroupUnknown:
+		phase = kubeflow.PodGroupUnschedulable
+	}
+	var latest *volcanov1beta1.PodGroupCondition
+	for i := range status.Conditions {
+		if cond := &status.Conditions[i]; latest == nil || !cond.LastTransitionTime.Before(&latest.LastTransitionTime) {
+			latest = cond
+		}
+	}
+	if latest != nil && latest.Type == volcanov1beta1.PodGroupUnschedulableType && latest.Status == corev1.ConditionTrue {
+		return kubeflow.PodGroupUnschedulable, latest.Message
+	}
+	return phase, ""
+}
+
 var _ PodGroupControl = &VolcanoCtrl{}
 
 // SchedulerPluginsCtrl is the implementation fo PodGroupControl with scheduler-plugins.
@@ -291,7 +365,7 @@ func (s *SchedulerPluginsCtrl) deletePodGroup(ctx context.Context, namespace, na
 	return s.Client.SchedulingV1alpha1().PodGroups(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
-func (s *SchedulerPluginsCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJobName string) {
+func (s *SchedulerPluginsCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJob *kubeflow.GroupJob, _ kubeflow.MPIReplicaType) {
 	if pts.Spec.SchedulerName != s.schedulerName {
 		klog.Warningf("%s scheduler is specified when gang-scheduling is enabled and it will be overwritten", pts.Spec.SchedulerName)
 	}
@@ -299,12 +373,12 @@ func (s *SchedulerPluginsCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSp
 	if pts.Labels == nil {
 		pts.Labels = make(map[string]string)
 	}
-	pts.Labels[schedv1alpha1.PodGroupLabel] = mpiJobName
+	pts.Labels[schedv1alpha1.PodGroupLabel] = mpiJob.Name
 }
 
 // calculatePGMinResources will calculate minResources for podGroup.
 // It calculates the sum of resources defined in all containers and will return the result.
-// If the number of replicas (.spec.mpiReplicaSpecs[Launcher].replicas + .spec.mpiReplicaSpecs[Worker].replicas)
+// If the number of replicas (.spec.mpiReplicaSpecs[Launcher].replicas + the replicas of all the worker groups)
 // is more of minMember, it reorders replicas according to each priorityClass setting in `podSpec.priorityClassName`
 // and then resources with a priority less than minMember will not be added to minResources.
 // Note that it doesn't account for the priorityClass specified in podSpec.priorityClassName
@@ -331,8 +405,69 @@ func (s *SchedulerPluginsCtrl) pgSpecsAreEqual(a, b metav1.Object) bool {
 	return equality.Semantic.DeepEqual(PGa.Spec, PGb.Spec)
 }
 
+// podGroupPhase maps the phase of the PodGroup. The coscheduling plugin has no
+// queue and doesn't report why it can't schedule a PodGroup.
+func (s *SchedulerPluginsCtrl) podGroupPhase(pg metav1.Object, _ []*corev1.Pod) (kubeflow.PodGroupPhase, string) {
+	switch pg.(*schedv1alpha1.PodGroup).Status.Phase {
+	case schedv1alpha1.PodGroupScheduling, schedv1alpha1.PodGroupRunning, schedv1alpha1.PodGroupFinished, schedv1alpha1.PodGroupFailed:
+		return kubeflow.PodGroupRunning, ""
+	case schedv1alpha1.PodGroupUnknown:
+		return kubeflow.PodGroupUnschedulable, ""
+	}
+	return kubeflow.PodGroupPending, ""
+}
+
 var _ PodGroupControl = &SchedulerPluginsCtrl{}
 
+// noPodGroupAPI implements the PodGroup methods of PodGroupControl for the
+// gang-schedulers that only rely on the labels and annotations of the pods.
+type noPodGroupAPI struct{}
+
+func (noPodGroupAPI) PodGroupSharedIndexInformer() cache.SharedIndexInformer {
+	return nil
+}
+
+func (noPodGroupAPI) StartInformerFactory(<-chan struct{}) {}
+
+func (noPodGroupAPI) newPodGroup(*kubeflow.GroupJob) metav1.Object {
+	return nil
+}
+
+func (noPodGroupAPI) getPodGroup(_, name string) (metav1.Object, error) {
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "podgroups"}, name)
+}
+
+func (noPodGroupAPI) createPodGroup(_ context.Context, pg metav1.Object) (metav1.Object, error) {
+	return pg, nil
+}
+
+func (noPodGroupAPI) updatePodGroup(_ context.Context, old, _ metav1.Object) (metav1.Object, error) {
+	return old, nil
+}
+
+func (noPodGroupAPI) deletePodGroup(context.Context, string, string) error {
+	return nil
+}
+
+func (noPodGroupAPI) calculatePGMinResources(*int32, *kubeflow.GroupJob) *corev1.ResourceList {
+	return nil
+}
+
+func (noPodGroupAPI) pgSpecsAreEqual(_, _ metav1.Object) bool {
+	return true
+}
+
+// allPodsBound returns whether there are pods and all of them are bound to a
+// node.
+func allPodsBound(pods []*corev1.Pod) bool {
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			return false
+		}
+	}
+	return len(pods) != 0
+}
+
 // calPGMinResource returns the minimum resource for mpiJob with minMembers
 func calPGMinResource(minMember *int32, mpiJob *kubeflow.GroupJob, pcLister schedulinglisters.PriorityClassLister) *corev1.ResourceList {
 	var order replicasOrder
@@ -355,23 +490,19 @@ func calPGMinResource(minMember *int32, mpiJob *kubeflow.GroupJob, pcLister sche
 	}
 
 	sort.Sort(sort.Reverse(order))
-	// Launcher + Worker > minMember
-	replicas := *order[0].Replicas
-	if len(order) > 1 {
-		// When using runLauncherAsWorker, there may be no worker.
-		replicas += *order[1].Replicas
+	// Launcher + workers > minMember
+	var replicas int32
+	for _, rp := range order {
+		replicas += ptr.Deref(rp.Replicas, 0)
 	}
 	if minMember != nil && replicas > *minMember {
-		// If the launcher and workers have the same priority, it treats workers as a lower priority.
-		if order[0].priority == order[1].priority {
-			wIndex := order.getWorkerIndex()
-			if wIndex == -1 {
-				klog.Warningf("Couldn't find the worker replicas")
-				return nil
-			}
-			order[wIndex].Replicas = ptr.To(*minMember - 1)
-		} else {
-			order[1].Replicas = ptr.To(*minMember - 1)
+		// Replicas are counted from the highest priority until minMember is
+		// reached, so the lowest priority workers are not added to minResources.
+		remaining := *minMember
+		for i := range order {
+			n := min(ptr.Deref(order[i].Replicas, 0), remaining)
+			order[i].Replicas = ptr.To(n)
+			remaining -= n
 		}
 	}
 
@@ -388,12 +519,14 @@ func calPGMinResource(minMember *int32, mpiJob *kubeflow.GroupJob, pcLister sche
 }
 
 // calculateMinAvailable calculates minAvailable for the PodGroup.
-// If the schedulingPolicy.minAvailable is nil, it returns returns `NUM(workers) + 1`; otherwise returns `schedulingPolicy.minAvailable`.
+// If the schedulingPolicy.minAvailable is nil, it returns returns `NUM(workers) + 1`
+// across all the worker groups, counting minReplicas for the Worker group of elastic
+// GroupJobs; otherwise returns `schedulingPolicy.minAvailable`.
 func calculateMinAvailable(mpiJob *kubeflow.GroupJob) *int32 {
 	if schedulingPolicy := mpiJob.Spec.RunPolicy.SchedulingPolicy; schedulingPolicy != nil && schedulingPolicy.MinAvailable != nil {
 		return schedulingPolicy.MinAvailable
 	}
-	return ptr.To(workerReplicas(mpiJob) + 1)
+	return ptr.To(minTotalWorkers(mpiJob) + 1)
 }
 
 // calculatePriorityClassName calculates the priorityClass name needed for podGroup according to the following priorities:
@@ -455,21 +588,19 @@ func (p replicasOrder) Len() int {
 	return len(p)
 }
 
+// Less treats workers as a lower priority than the launcher when they have the
+// same priority. Worker groups with the same priority compare in reverse name
+// order, so that sort.Reverse orders them by name.
 func (p replicasOrder) Less(i, j int) bool {
-	return p[i].priority < p[j].priority
+	if p[i].priority != p[j].priority {
+		return p[i].priority < p[j].priority
+	}
+	if p[i].replicaType == kubeflow.MPIReplicaTypeLauncher || p[j].replicaType == kubeflow.MPIReplicaTypeLauncher {
+		return p[j].replicaType == kubeflow.MPIReplicaTypeLauncher && p[i].replicaType != kubeflow.MPIReplicaTypeLauncher
+	}
+	return p[i].replicaType > p[j].replicaType
 }
 
 func (p replicasOrder) Swap(i, j int) {
 	p[i], p[j] = p[j], p[i]
 }
-
-// getWorkerIndex will return an index holding the replicaSpec for the worker.
-// If the worker doesn't exist, it returns -1.
-func (p replicasOrder) getWorkerIndex() int {
-	for i := range p {
-		if p[i].replicaType == kubeflow.MPIReplicaTypeWorker {
-			return i
-		}
-	}
-	return -1
-}
@@ -0,0 +1,95 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	kueueQueueNameLabel               = "kueue.x-k8s.io/queue-name"
+	kueuePodGroupNameLabel            = "kueue.x-k8s.io/pod-group-name"
+	kueuePodGroupTotalCountAnnotation = "kueue.x-k8s.io/pod-group-total-count"
+	kueueAdmissionGate                = "kueue.x-k8s.io/admission"
+)
+
+// KueueCtrl is the implementation of PodGroupControl with the plain pod groups
+// of Kueue. Kueue gates the pods of a group until all of them exist and the
+// quota of their queue admits them together. The pods are then bound by the
+// scheduler of their template.
+type KueueCtrl struct {
+	noPodGroupAPI
+}
+
+func NewKueueCtrl() *KueueCtrl {
+	return &KueueCtrl{}
+}
+
+// decoratePodTemplateSpec adds the workers to the pod group of the GroupJob.
+// The launcher is left out: Kueue doesn't manage the pods owned by a Job, a
+// kind it has its own integration for, so the group would never be complete.
+func (k *KueueCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType) {
+	if rType == kubeflow.MPIReplicaTypeLauncher {
+		return
+	}
+	if pts.Labels == nil {
+		pts.Labels = make(map[string]string)
+	}
+	pts.Labels[kueuePodGroupNameLabel] = mpiJob.Name
+	if schedPolicy := mpiJob.Spec.RunPolicy.SchedulingPolicy; schedPolicy != nil && len(schedPolicy.Queue) != 0 {
+		pts.Labels[kueueQueueNameLabel] = schedPolicy.Queue
+	}
+	if pts.Annotations == nil {
+		pts.Annotations = make(map[string]string)
+	}
+	pts.Annotations[kueuePodGroupTotalCountAnnotation] = strconv.Itoa(int(kueuePodGroupTotalCount(mpiJob)))
+}
+
+// kueuePodGroupTotalCount returns the number of pods of the pod group: the
+// workers of all the worker groups.
+func kueuePodGroupTotalCount(mpiJob *kubeflow.GroupJob) int32 {
+	var count int32
+	for _, rType := range workerGroups(mpiJob) {
+		count += ptr.Deref(mpiJob.Spec.MPIReplicaSpecs[rType].Replicas, 0)
+	}
+	return count
+}
+
+// podGroupPhase returns the phase of the pods: Pending while Kueue gates them,
+// Inqueue once admitted and Running once bound.
+func (k *KueueCtrl) podGroupPhase(_ metav1.Object, pods []*corev1.Pod) (kubeflow.PodGroupPhase, string) {
+	gated := slices.ContainsFunc(pods, func(pod *corev1.Pod) bool {
+		return slices.ContainsFunc(pod.Spec.SchedulingGates, func(gate corev1.PodSchedulingGate) bool {
+			return gate.Name == kueueAdmissionGate
+		})
+	})
+	switch {
+	case len(pods) == 0 || gated:
+		return kubeflow.PodGroupPending, ""
+	case allPodsBound(pods):
+		return kubeflow.PodGroupRunning, ""
+	default:
+		return kubeflow.PodGroupInqueue, ""
+	}
+}
+
+var _ PodGroupControl = &KueueCtrl{}
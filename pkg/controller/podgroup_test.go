@@ -15,21 +15,28 @@
 package controller
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/coreweave/group-operator/pkg/client/clientset/versioned/scheme"
 )
 
 var (
@@ -385,6 +392,7 @@ func TestCalcPriorityClassName(t *testing.T) {
 
 func TestDecoratePodTemplateSpec(t *testing.T) {
 	jobName := "test-mpijob"
+	mpiJob := &kubeflow.GroupJob{ObjectMeta: metav1.ObjectMeta{Name: jobName}}
 	schedulerPluginsSchedulerName := "default-scheduler"
 	tests := map[string]struct {
 		wantVolcanoPts, wantSchedPts *corev1.PodTemplateSpec
@@ -420,7 +428,7 @@ func TestDecoratePodTemplateSpec(t *testing.T) {
 				Client:        volcanoF.volcanoClient,
 				schedulerName: "volcano",
 			}
-			volcanoPGCtrl.decoratePodTemplateSpec(volcanoInput, jobName)
+			volcanoPGCtrl.decoratePodTemplateSpec(volcanoInput, mpiJob, kubeflow.MPIReplicaTypeWorker)
 			if diff := cmp.Diff(tc.wantVolcanoPts, volcanoInput); len(diff) != 0 {
 				t.Fatalf("Unexpected decoratePodTemplateSpec for the volcano (-want,+got):\n%s", diff)
 			}
@@ -430,7 +438,7 @@ func TestDecoratePodTemplateSpec(t *testing.T) {
 				Client:        schedF.schedClient,
 				schedulerName: schedulerPluginsSchedulerName,
 			}
-			schedPGCtrl.decoratePodTemplateSpec(schedInput, jobName)
+			schedPGCtrl.decoratePodTemplateSpec(schedInput, mpiJob, kubeflow.MPIReplicaTypeWorker)
 			if diff := cmp.Diff(tc.wantSchedPts, schedInput); len(diff) != 0 {
 				t.Fatalf("Unexpected decoratePodTemplateSpec for the scheduler-plugins (-want,+got):\n%s", diff)
 			}
@@ -775,6 +783,86 @@ func TestCalculatePGMinResources(t *testing.T) {
 				corev1.ResourceMemory: resource.MustParse("68Gi"),
 			},
 		},
+		"with worker groups": {
+			priorityClasses: []*schedulingv1.PriorityClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "high",
+					},
+					Value: 100_010,
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "low",
+					},
+					Value: 10_010,
+				},
+			},
+			minMember: ptr.To[int32](4),
+			job: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas: ptr.To[int32](1),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									PriorityClassName: "high",
+									Containers: []corev1.Container{
+										{
+											Resources: corev1.ResourceRequirements{
+												Requests: corev1.ResourceList{
+													corev1.ResourceCPU: resource.MustParse("1"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									PriorityClassName: "low",
+									Containers: []corev1.Container{
+										{
+											Resources: corev1.ResourceRequirements{
+												Requests: corev1.ResourceList{
+													corev1.ResourceCPU: resource.MustParse("2"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"GPU": {
+							Replicas: ptr.To[int32](2),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									PriorityClassName: "high",
+									Containers: []corev1.Container{
+										{
+											Resources: corev1.ResourceRequirements{
+												Requests: corev1.ResourceList{
+													corev1.ResourceCPU: resource.MustParse("4"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("11"),
+			},
+		},
 	}
 	for name, tc := range schedTests {
 		t.Run(name, func(t *testing.T) {
@@ -912,6 +1000,26 @@ func TestCalculateMinAvailable(t *testing.T) {
 			},
 			want: 100,
 		},
+		"elastic workers": {
+			job: &kubeflow.GroupJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubeflow.GroupJobSpec{
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas: ptr.To[int32](1),
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:    ptr.To[int32](8),
+							MinReplicas: ptr.To[int32](4),
+							MaxReplicas: ptr.To[int32](8),
+						},
+					},
+				},
+			},
+			want: 5,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -945,8 +1053,22 @@ func TestReplicasOrder(t *testing.T) {
 				{priority: 0, replicaType: kubeflow.MPIReplicaTypeLauncher, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &lancherReplic}},
 			},
 			expected: replicasOrder{
+				{priority: 0, replicaType: kubeflow.MPIReplicaTypeLauncher, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &lancherReplic}},
+				{priority: 0, replicaType: kubeflow.MPIReplicaTypeWorker, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
+			},
+		},
+		"1-lancher, worker groups, equal priority": {
+			original: replicasOrder{
+				{priority: 0, replicaType: "Highmem", ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
 				{priority: 0, replicaType: kubeflow.MPIReplicaTypeWorker, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
 				{priority: 0, replicaType: kubeflow.MPIReplicaTypeLauncher, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &lancherReplic}},
+				{priority: 1, replicaType: "Gpu", ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
+			},
+			expected: replicasOrder{
+				{priority: 1, replicaType: "Gpu", ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
+				{priority: 0, replicaType: kubeflow.MPIReplicaTypeLauncher, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &lancherReplic}},
+				{priority: 0, replicaType: "Highmem", ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
+				{priority: 0, replicaType: kubeflow.MPIReplicaTypeWorker, ReplicaSpec: kubeflow.ReplicaSpec{Replicas: &wokerReplic}},
 			},
 		},
 	}
@@ -959,3 +1081,376 @@ func TestReplicasOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestPodGroupPhase(t *testing.T) {
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+	volcanoTests := map[string]struct {
+		status    volcanov1beta1.PodGroupStatus
+		wantPhase kubeflow.PodGroupPhase
+		wantMsg   string
+	}{
+		"not considered yet": {
+			wantPhase: kubeflow.PodGroupPending,
+		},
+		"inqueue": {
+			status:    volcanov1beta1.PodGroupStatus{Phase: volcanov1beta1.PodGroupInqueue},
+			wantPhase: kubeflow.PodGroupInqueue,
+		},
+		"unschedulable": {
+			status: volcanov1beta1.PodGroupStatus{
+				Phase: volcanov1beta1.PodGroupInqueue,
+				Conditions: []volcanov1beta1.PodGroupCondition{
+					{
+						Type:               volcanov1beta1.PodGroupScheduled,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: earlier,
+					},
+					{
+						Type:               volcanov1beta1.PodGroupUnschedulableType,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: now,
+						Message:            "3/3 tasks in gang unschedulable: 3 Insufficient nvidia.com/gpu",
+					},
+				},
+			},
+			wantPhase: kubeflow.PodGroupUnschedulable,
+			wantMsg:   "3/3 tasks in gang unschedulable: 3 Insufficient nvidia.com/gpu",
+		},
+		"scheduled after being unschedulable": {
+			status: volcanov1beta1.PodGroupStatus{
+				Phase: volcanov1beta1.PodGroupInqueue,
+				Conditions: []volcanov1beta1.PodGroupCondition{
+					{
+						Type:               volcanov1beta1.PodGroupUnschedulableType,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: earlier,
+					},
+					{
+						Type:               volcanov1beta1.PodGroupScheduled,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: now,
+					},
+				},
+			},
+			wantPhase: kubeflow.PodGroupInqueue,
+		},
+		"running": {
+			status:    volcanov1beta1.PodGroupStatus{Phase: volcanov1beta1.PodGroupRunning},
+			wantPhase: kubeflow.PodGroupRunning,
+		},
+	}
+	for name, tc := range volcanoTests {
+		t.Run("volcano "+name, func(t *testing.T) {
+			phase, msg := (&VolcanoCtrl{}).podGroupPhase(&volcanov1beta1.PodGroup{Status: tc.status}, nil)
+			if phase != tc.wantPhase || msg != tc.wantMsg {
+				t.Errorf("Got (%q, %q), want (%q, %q)", phase, msg, tc.wantPhase, tc.wantMsg)
+			}
+		})
+	}
+
+	schedTests := map[schedv1alpha1.PodGroupPhase]kubeflow.PodGroupPhase{
+		"":                               kubeflow.PodGroupPending,
+		schedv1alpha1.PodGroupPending:    kubeflow.PodGroupPending,
+		schedv1alpha1.PodGroupScheduling: kubeflow.PodGroupRunning,
+		schedv1alpha1.PodGroupRunning:    kubeflow.PodGroupRunning,
+		schedv1alpha1.PodGroupUnknown:    kubeflow.PodGroupUnschedulable,
+	}
+	for schedPhase, want := range schedTests {
+		t.Run("scheduler-plugins "+string(schedPhase), func(t *testing.T) {
+			pg := &schedv1alpha1.PodGroup{Status: schedv1alpha1.PodGroupStatus{Phase: schedPhase}}
+			if phase, _ := (&SchedulerPluginsCtrl{}).podGroupPhase(pg, nil); phase != want {
+				t.Errorf("Got %q, want %q", phase, want)
+			}
+		})
+	}
+}
+
+func TestUpdatePodGroupStatus(t *testing.T) {
+	mpiJob := newGroupJob("test", ptr.To[int32](2), nil, nil)
+	c := &GroupJobController{
+		PodGroupCtrl: &SchedulerPluginsCtrl{},
+		recorder:     record.NewFakeRecorder(10),
+	}
+	pendingPodGroup := &schedv1alpha1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Status:     schedv1alpha1.PodGroupStatus{Phase: schedv1alpha1.PodGroupPending},
+	}
+
+	c.updatePodGroupStatus(mpiJob, pendingPodGroup, nil)
+	wantCond := newCondition(kubeflow.JobGangScheduled, corev1.ConditionFalse, "Pending", "PodGroup default/test is Pending.")
+	if diff := cmp.Diff(&wantCond, getCondition(mpiJob.Status, kubeflow.JobGangScheduled), ignoreConditionTimes); diff != "" {
+		t.Errorf("Unexpected condition (-want,+got):\n%s", diff)
+	}
+
+	// The scheduler reports why it can't bind a pod.
+	pods := []*corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.",
+			}},
+		},
+	}}
+	c.updatePodGroupStatus(mpiJob, pendingPodGroup, pods)
+	if got, want := mpiJob.Status.PodGroupPhase, kubeflow.PodGroupUnschedulable; got != want {
+		t.Errorf("Got phase %q, want %q", got, want)
+	}
+	wantCond = newCondition(kubeflow.JobGangScheduled, corev1.ConditionFalse, "Unschedulable", "pod test-worker-0: 0/4 nodes are available: 4 Insufficient nvidia.com/gpu.")
+	if diff := cmp.Diff(&wantCond, getCondition(mpiJob.Status, kubeflow.JobGangScheduled), ignoreConditionTimes); diff != "" {
+		t.Errorf("Unexpected condition (-want,+got):\n%s", diff)
+	}
+
+	runningPodGroup := pendingPodGroup.DeepCopy()
+	runningPodGroup.Status.Phase = schedv1alpha1.PodGroupRunning
+	c.updatePodGroupStatus(mpiJob, runningPodGroup, nil)
+	if got, want := mpiJob.Status.PodGroupPhase, kubeflow.PodGroupRunning; got != want {
+		t.Errorf("Got phase %q, want %q", got, want)
+	}
+	if !hasCondition(mpiJob.Status, kubeflow.JobGangScheduled) {
+		t.Errorf("GroupJob has no %s condition", kubeflow.JobGangScheduled)
+	}
+}
+
+func TestNewPodGroupControl(t *testing.T) {
+	tests := map[string]PodGroupControl{
+		"":                  nil,
+		"volcano":           &VolcanoCtrl{},
+		"scheduler-plugins": &SchedulerPluginsCtrl{},
+		"yunikorn":          &YuniKornCtrl{},
+		"kueue":             &KueueCtrl{},
+		// Other names are the names of a scheduler-plugins scheduler.
+		"default-scheduler": &SchedulerPluginsCtrl{},
+	}
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, name)
+			got := newPodGroupControl(podGroupControlConfig{
+				volcanoClient: f.volcanoClient,
+				schedClient:   f.schedClient,
+				schedulerName: name,
+			})
+			if reflect.TypeOf(got) != reflect.TypeOf(want) {
+				t.Errorf("Got %T, want %T", got, want)
+			}
+		})
+	}
+}
+
+func TestYuniKornDecoratePodTemplateSpec(t *testing.T) {
+	mpiJob := newGroupJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{
+		Queue:                  "root.training",
+		ScheduleTimeoutSeconds: ptr.To[int32](300),
+	}
+	workerSpec := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec
+	workerSpec.NodeSelector = map[string]string{"gpu": "true"}
+	workerSpec.Containers[0].Resources.Limits = corev1.ResourceList{"example.com/gpu": resource.MustParse("8")}
+	ctrl := NewYuniKornCtrl()
+
+	pts := &corev1.PodTemplateSpec{}
+	ctrl.decoratePodTemplateSpec(pts, mpiJob, kubeflow.MPIReplicaTypeWorker)
+	want := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				yuniKornAppIDLabel: "default-test",
+				yuniKornQueueLabel: "root.training",
+			},
+			Annotations: map[string]string{
+				yuniKornTaskGroupsAnnotation:             `[{"name":"launcher","minMember":1},{"name":"worker","minMember":2,"minResource":{"example.com/gpu":"8"},"nodeSelector":{"gpu":"true"}}]`,
+				yuniKornTaskGroupNameAnnotation:          "worker",
+				yuniKornSchedulingPolicyParamsAnnotation: "placeholderTimeoutInSeconds=300",
+			},
+		},
+		Spec: corev1.PodSpec{SchedulerName: "yunikorn"},
+	}
+	if diff := cmp.Diff(want, pts); diff != "" {
+		t.Errorf("Unexpected worker template (-want,+got):\n%s", diff)
+	}
+
+	pts = &corev1.PodTemplateSpec{}
+	ctrl.decoratePodTemplateSpec(pts, mpiJob, kubeflow.MPIReplicaTypeLauncher)
+	if got := pts.Annotations[yuniKornTaskGroupNameAnnotation]; got != "launcher" {
+		t.Errorf("Got launcher task group %q, want %q", got, "launcher")
+	}
+}
+
+func TestYuniKornAppID(t *testing.T) {
+	long := strings.Repeat("a", 60)
+	tests := map[string]struct {
+		namespace string
+		name      string
+		want      string
+	}{
+		"short": {
+			namespace: "default",
+			name:      "test",
+			want:      "default-test",
+		},
+		"long": {
+			namespace: "default",
+			name:      long + "-1",
+			want:      "default-" + long[:46] + "-",
+		},
+	}
+	ids := sets.New[string]()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newGroupJob(tc.name, ptr.To[int32](1), nil, nil)
+			mpiJob.Namespace = tc.namespace
+			got := yuniKornAppID(mpiJob)
+			if !strings.HasPrefix(got, tc.want) {
+				t.Errorf("Got application ID %q, want prefix %q", got, tc.want)
+			}
+			if errs := validation.IsValidLabelValue(got); len(errs) != 0 {
+				t.Errorf("Invalid application ID %q: %v", got, errs)
+			}
+			ids.Insert(got)
+		})
+	}
+	// GroupJobs whose names only differ after the truncation get different IDs.
+	mpiJob := newGroupJob(long+"-2", ptr.To[int32](1), nil, nil)
+	if id := yuniKornAppID(mpiJob); ids.Has(id) {
+		t.Errorf("Application ID %q is not unique", id)
+	}
+}
+
+func TestYuniKornTaskGroupsElastic(t *testing.T) {
+	mpiJob := newGroupJob("test", ptr.To[int32](4), nil, nil)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].MinReplicas = ptr.To[int32](0)
+	mpiJob.Spec.MPIReplicaSpecs["Gpu"] = &kubeflow.ReplicaSpec{
+		Replicas: ptr.To[int32](2),
+		Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{}}}},
+	}
+	var got []string
+	for _, tg := range yuniKornTaskGroups(mpiJob) {
+		got = append(got, fmt.Sprintf("%s=%d", tg.Name, tg.MinMember))
+	}
+	// The Worker group has no member without which the GroupJob can't run.
+	if diff := cmp.Diff([]string{"launcher=1", "gpu=2"}, got); diff != "" {
+		t.Errorf("Unexpected task groups (-want,+got):\n%s", diff)
+	}
+}
+
+func TestKueueDecoratePodTemplateSpec(t *testing.T) {
+	mpiJob := newGroupJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.MPIReplicaSpecs["Gpu"] = &kubeflow.ReplicaSpec{Replicas: ptr.To[int32](3)}
+	mpiJob.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{Queue: "user-queue"}
+	ctrl := NewKueueCtrl()
+
+	pts := &corev1.PodTemplateSpec{}
+	ctrl.decoratePodTemplateSpec(pts, mpiJob, kubeflow.MPIReplicaTypeWorker)
+	want := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				kueuePodGroupNameLabel: "test",
+				kueueQueueNameLabel:    "user-queue",
+			},
+			Annotations: map[string]string{
+				kueuePodGroupTotalCountAnnotation: "5",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, pts); diff != "" {
+		t.Errorf("Unexpected worker template (-want,+got):\n%s", diff)
+	}
+
+	pts = &corev1.PodTemplateSpec{}
+	ctrl.decoratePodTemplateSpec(pts, mpiJob, kubeflow.MPIReplicaTypeLauncher)
+	if diff := cmp.Diff(&corev1.PodTemplateSpec{}, pts); diff != "" {
+		t.Errorf("Unexpected launcher template (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPodGroupPhaseWithoutPodGroupAPI(t *testing.T) {
+	gated := &corev1.Pod{Spec: corev1.PodSpec{SchedulingGates: []corev1.PodSchedulingGate{{Name: kueueAdmissionGate}}}}
+	pending := &corev1.Pod{}
+	bound := &corev1.Pod{Spec: corev1.PodSpec{NodeName: "node-a"}}
+	tests := map[string]struct {
+		ctrl      PodGroupControl
+		pods      []*corev1.Pod
+		wantPhase kubeflow.PodGroupPhase
+	}{
+		"yunikorn without pods": {
+			ctrl:      &YuniKornCtrl{},
+			wantPhase: kubeflow.PodGroupPending,
+		},
+		"yunikorn pending": {
+			ctrl:      &YuniKornCtrl{},
+			pods:      []*corev1.Pod{bound, pending},
+			wantPhase: kubeflow.PodGroupPending,
+		},
+		"yunikorn running": {
+			ctrl:      &YuniKornCtrl{},
+			pods:      []*corev1.Pod{bound, bound},
+			wantPhase: kubeflow.PodGroupRunning,
+		},
+		"kueue gated": {
+			ctrl:      &KueueCtrl{},
+			pods:      []*corev1.Pod{gated, pending},
+			wantPhase: kubeflow.PodGroupPending,
+		},
+		"kueue admitted": {
+			ctrl:      &KueueCtrl{},
+			pods:      []*corev1.Pod{bound, pending},
+			wantPhase: kubeflow.PodGroupInqueue,
+		},
+		"kueue running": {
+			ctrl:      &KueueCtrl{},
+			pods:      []*corev1.Pod{bound, bound},
+			wantPhase: kubeflow.PodGroupRunning,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if phase, _ := tc.ctrl.podGroupPhase(nil, tc.pods); phase != tc.wantPhase {
+				t.Errorf("Got %q, want %q", phase, tc.wantPhase)
+			}
+		})
+	}
+}
+
+func TestKueueResourcesCreated(t *testing.T) {
+	f := newFixture(t, "kueue")
+	var replicas int32 = 2
+	startTime := metav1.Now()
+	mpiJob := newGroupJob("foo", &replicas, &startTime, nil)
+	f.setUpGroupJob(mpiJob)
+
+	fmjc := f.newFakeGroupJobController()
+	fmjc.PodGroupCtrl = NewKueueCtrl()
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.expectCreateServiceAction(newJobService(mpiJobCopy))
+	cfgMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(cfgMap, mpiJobCopy, nil)
+	f.expectCreateConfigMapAction(cfgMap)
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Failed creating secret: %v", err)
+	}
+	f.expectCreateSecretAction(secret)
+	// No PodGroup is created.
+	for i := 0; i < int(replicas); i++ {
+		f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
+	}
+	f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
+
+	mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "GroupJob default/foo is created.")}
+	// Kueue isn't running to gate the workers.
+	mpiJobCopy.Status.PodGroupPhase = kubeflow.PodGroupInqueue
+	updateGroupJobConditions(mpiJobCopy, kubeflow.JobGangScheduled, corev1.ConditionFalse, string(kubeflow.PodGroupInqueue), "PodGroup default/foo is Inqueue.")
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {},
+		kubeflow.MPIReplicaTypeWorker: {
+			Selector: workerSelectorString(mpiJob, kubeflow.MPIReplicaTypeWorker),
+		},
+	}
+	setUpGroupJobTimestamp(mpiJobCopy, &startTime, nil)
+	f.expectUpdateGroupJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
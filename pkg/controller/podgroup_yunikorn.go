@@ -0,0 +1,170 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	"github.com/coreweave/group-operator/cmd/group-operator/app/options"
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	yuniKornAppIDLabel                       = "yunikorn.apache.org/app-id"
+	yuniKornQueueLabel                       = "yunikorn.apache.org/queue"
+	yuniKornTaskGroupsAnnotation             = "yunikorn.apache.org/task-groups"
+	yuniKornTaskGroupNameAnnotation          = "yunikorn.apache.org/task-group-name"
+	yuniKornSchedulingPolicyParamsAnnotation = "yunikorn.apache.org/schedulingPolicyParameters"
+)
+
+// yuniKornTaskGroup is an entry of the task-groups annotation. YuniKorn
+// creates minMember placeholder pods with the minResource and the placement
+// constraints of each task group, and replaces them with the pods once all
+// of them are allocated.
+type yuniKornTaskGroup struct {
+	Name         string              `json:"name"`
+	MinMember    int32               `json:"minMember"`
+	MinResource  corev1.ResourceList `json:"minResource,omitempty"`
+	NodeSelector map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration `json:"tolerations,omitempty"`
+	Affinity     *corev1.Affinity    `json:"affinity,omitempty"`
+}
+
+// YuniKornCtrl is the implementation of PodGroupControl with Apache YuniKorn.
+// YuniKorn has no PodGroup API: the gang is described by task groups in the
+// annotations of the pods, one per replica type.
+type YuniKornCtrl struct {
+	noPodGroupAPI
+	schedulerName string
+}
+
+func NewYuniKornCtrl() *YuniKornCtrl {
+	return &YuniKornCtrl{schedulerName: options.GangSchedulerYuniKorn}
+}
+
+func (y *YuniKornCtrl) decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJob *kubeflow.GroupJob, rType kubeflow.MPIReplicaType) {
+	if pts.Spec.SchedulerName != y.schedulerName {
+		klog.Warningf("%s scheduler is specified when gang-scheduling is enabled and it will be overwritten", pts.Spec.SchedulerName)
+	}
+	pts.Spec.SchedulerName = y.schedulerName
+	if pts.Labels == nil {
+		pts.Labels = make(map[string]string)
+	}
+	pts.Labels[yuniKornAppIDLabel] = yuniKornAppID(mpiJob)
+	if schedPolicy := mpiJob.Spec.RunPolicy.SchedulingPolicy; schedPolicy != nil && len(schedPolicy.Queue) != 0 {
+		pts.Labels[yuniKornQueueLabel] = schedPolicy.Queue
+	}
+
+	taskGroups := yuniKornTaskGroups(mpiJob)
+	if len(taskGroups) == 0 {
+		return
+	}
+	data, err := json.Marshal(taskGroups)
+	if err != nil {
+		klog.Errorf("Failed to marshal the YuniKorn task groups of GroupJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		return
+	}
+	if pts.Annotations == nil {
+		pts.Annotations = make(map[string]string)
+	}
+	pts.Annotations[yuniKornTaskGroupsAnnotation] = string(data)
+	for _, tg := range taskGroups {
+		// The pods of replica types without task group are not part of the
+		// gang.
+		if tg.Name == workerGroupName(rType) {
+			pts.Annotations[yuniKornTaskGroupNameAnnotation] = tg.Name
+		}
+	}
+	if timeout, ok := scheduleTimeout(mpiJob); ok {
+		// The placeholders are released when the gang isn't allocated
+		// in time, leaving the controller to apply the schedule timeout.
+		pts.Annotations[yuniKornSchedulingPolicyParamsAnnotation] = fmt.Sprintf("placeholderTimeoutInSeconds=%d", int(timeout.Seconds()))
+	}
+}
+
+// yuniKornAppID returns the application ID of mpiJob, which must be unique in
+// the cluster and a valid label value: <namespace>-<name>, truncated and
+// suffixed with a hash of both when longer than 63 characters.
+func yuniKornAppID(mpiJob *kubeflow.GroupJob) string {
+	appID := fmt.Sprintf("%s-%s", mpiJob.Namespace, mpiJob.Name)
+	if len(appID) <= validation.LabelValueMaxLength {
+		return appID
+	}
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(mpiJob.Namespace + "/" + mpiJob.Name))
+	suffix := fmt.Sprintf("-%08x", hasher.Sum32())
+	return appID[:validation.LabelValueMaxLength-len(suffix)] + suffix
+}
+
+// yuniKornTaskGroups returns the task groups of mpiJob: the launcher and each
+// worker group, with minReplicas members for the Worker group of elastic
+// GroupJobs. Replica types without members are left out, as YuniKorn requires
+// at least one.
+func yuniKornTaskGroups(mpiJob *kubeflow.GroupJob) []yuniKornTaskGroup {
+	var taskGroups []yuniKornTaskGroup
+	for _, rType := range append([]kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeLauncher}, workerGroups(mpiJob)...) {
+		spec := mpiJob.Spec.MPIReplicaSpecs[rType]
+		if spec == nil {
+			continue
+		}
+		var minMember int32
+		switch rType {
+		case kubeflow.MPIReplicaTypeLauncher:
+			minMember = 1
+		case kubeflow.MPIReplicaTypeWorker:
+			minMember = minWorkerReplicas(mpiJob)
+		default:
+			minMember = ptr.Deref(spec.Replicas, 0)
+		}
+		if minMember == 0 {
+			continue
+		}
+		minResource := corev1.ResourceList{}
+		for _, c := range spec.Template.Spec.Containers {
+			addResources(minResource, c.Resources, 1)
+		}
+		if len(minResource) == 0 {
+			minResource = nil
+		}
+		taskGroups = append(taskGroups, yuniKornTaskGroup{
+			Name:         workerGroupName(rType),
+			MinMember:    minMember,
+			MinResource:  minResource,
+			NodeSelector: spec.Template.Spec.NodeSelector,
+			Tolerations:  spec.Template.Spec.Tolerations,
+			Affinity:     spec.Template.Spec.Affinity,
+		})
+	}
+	return taskGroups
+}
+
+// podGroupPhase returns the phase of the pods, as YuniKorn keeps them pending
+// until the placeholders of the whole gang are allocated.
+func (y *YuniKornCtrl) podGroupPhase(_ metav1.Object, pods []*corev1.Pod) (kubeflow.PodGroupPhase, string) {
+	if allPodsBound(pods) {
+		return kubeflow.PodGroupRunning, ""
+	}
+	return kubeflow.PodGroupPending, ""
+}
+
+var _ PodGroupControl = &YuniKornCtrl{}
@@ -0,0 +1,488 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+)
+
+const (
+	// caCertKey holds the CA bundle injected into the webhook configurations.
+	// Its first certificate is the current CA; the one after it, if any, is
+	// the previous CA.
+	caCertKey = "ca.crt"
+	// caKeyKey holds the private key of the current CA.
+	caKeyKey = "ca.key"
+
+	caValidity   = 10 * 365 * 24 * time.Hour
+	leafValidity = 365 * 24 * time.Hour
+	// renewBefore is how long before the expiry of the serving certificate
+	// it is rotated.
+	renewBefore = 30 * 24 * time.Hour
+	// caOverlap is how long the previous CA stays in the bundle after the CA
+	// is rotated, so that the replicas still serving a certificate signed by
+	// it keep being trusted until they load the new one.
+	caOverlap = 24 * time.Hour
+	// resyncPeriod is how often the certificates and the CA bundle of the
+	// webhook configurations are checked.
+	resyncPeriod = time.Hour
+)
+
+// CertConfig describes where the webhook certificates are stored and which
+// webhook configurations and CRD trust them.
+type CertConfig struct {
+	// Namespace of the webhook Service and the certificate Secret.
+	Namespace string
+	// ServiceName is the name of the Service fronting the webhook server.
+	ServiceName string
+	// SecretName is the name of the Secret holding the certificates.
+	SecretName string
+	// WebhookConfigurationName is the name of the Mutating and
+	// ValidatingWebhookConfigurations to inject the CA bundle into.
+	WebhookConfigurationName string
+	// CRDName is the name of the CustomResourceDefinition whose conversion
+	// webhook gets the CA bundle injected.
+	CRDName string
+}
+
+// CertManager generates a self-signed CA and a serving certificate for the
+// webhook Service, stores them in a Secret so that all replicas of the
+// operator share them, and injects the CA into the webhook configurations
+// and the conversion webhook of the CRD. The CA outlives the serving
+// certificates, which are rotated on their own, and every replica serves the
+// certificate it last saw in the Secret.
+type CertManager struct {
+	client    kubernetes.Interface
+	crdClient apiextensionsclientset.Interface
+	config    CertConfig
+	cert      atomic.Pointer[tls.Certificate]
+	now       func() time.Time
+}
+
+// NewCertManager returns a CertManager for the given configuration.
+func NewCertManager(client kubernetes.Interface, crdClient apiextensionsclientset.Interface, config CertConfig) *CertManager {
+	return &CertManager{
+		client:    client,
+		crdClient: crdClient,
+		config:    config,
+		now:       time.Now,
+	}
+}
+
+// GetCertificate returns the current serving certificate. It is meant to be
+// used as tls.Config.GetCertificate.
+func (m *CertManager) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := m.cert.Load()
+	if cert == nil {
+		return nil, errors.New("webhook serving certificate is not ready")
+	}
+	return cert, nil
+}
+
+// Run keeps the certificates up to date until ctx is cancelled. The serving
+// certificate is reloaded as soon as the Secret changes, whichever replica
+// rotated it.
+func (m *CertManager) Run(ctx context.Context) {
+	factory := informers.NewSharedInformerFactoryWithOptions(m.client, 0,
+		informers.WithNamespace(m.config.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", m.config.SecretName).String()
+		}))
+	if _, err := factory.Core().V1().Secrets().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: m.onSecret,
+		UpdateFunc: func(_, obj interface{}) {
+			m.onSecret(obj)
+		},
+	}); err != nil {
+		klog.Errorf("Failed to watch the webhook certificate secret: %v", err)
+	}
+	factory.Start(ctx.Done())
+	defer factory.Shutdown()
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.Ensure(ctx); err != nil {
+			klog.Errorf("Failed to ensure the webhook certificates: %v", err)
+		}
+	}, resyncPeriod)
+}
+
+func (m *CertManager) onSecret(obj interface{}) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok || secret.Name != m.config.SecretName {
+		return
+	}
+	if err := m.load(secret.Data); err != nil {
+		klog.Errorf("Failed to reload the webhook serving certificate: %v", err)
+	}
+}
+
+// Ensure makes sure that the Secret holds a valid CA and serving certificate,
+// loads the serving certificate, and injects the CA bundle into the webhook
+// configurations.
+func (m *CertManager) Ensure(ctx context.Context) error {
+	secrets := m.client.CoreV1().Secrets(m.config.Namespace)
+	secret, err := secrets.Get(ctx, m.config.SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.config.SecretName,
+				Namespace: m.config.Namespace,
+			},
+			Type: corev1.SecretTypeTLS,
+		}
+		if secret.Data, _, err = m.rotate(nil); err != nil {
+			return err
+		}
+		secret, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			// Another replica created it first.
+			secret, err = secrets.Get(ctx, m.config.SecretName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("getting webhook certificate secret: %w", err)
+	}
+	data, changed, err := m.rotate(secret.Data)
+	if err != nil {
+		return err
+	}
+	if changed {
+		updated := secret.DeepCopy()
+		updated.Data = data
+		updated, err = secrets.Update(ctx, updated, metav1.UpdateOptions{})
+		if apierrors.IsConflict(err) {
+			// Another replica rotated the certificates first.
+			updated, err = secrets.Get(ctx, m.config.SecretName, metav1.GetOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("updating webhook certificate secret: %w", err)
+		}
+		secret = updated
+	}
+	if err := m.load(secret.Data); err != nil {
+		return err
+	}
+	if err := m.injectCABundle(ctx, secret.Data[caCertKey]); err != nil {
+		return err
+	}
+	return m.injectConversionCABundle(ctx, secret.Data[caCertKey])
+}
+
+// load makes the serving certificate in data the one served.
+func (m *CertManager) load(data map[string][]byte) error {
+	cert, err := tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("loading webhook serving certificate: %w", err)
+	}
+	m.cert.Store(&cert)
+	return nil
+}
+
+// dnsNames returns the names the serving certificate is valid for.
+func (m *CertManager) dnsNames() []string {
+	svc := m.config.ServiceName
+	ns := m.config.Namespace
+	return []string{
+		svc,
+		fmt.Sprintf("%s.%s", svc, ns),
+		fmt.Sprintf("%s.%s.svc", svc, ns),
+		fmt.Sprintf("%s.%s.svc.cluster.local", svc, ns),
+	}
+}
+
+// rotate returns the Secret data with the CA replaced if it is missing or
+// expires before a new serving certificate would, the CA bundle updated and
+// the serving certificate replaced if it isn't valid, and whether anything
+// changed. data isn't modified.
+func (m *CertManager) rotate(data map[string][]byte) (map[string][]byte, bool, error) {
+	rotated := make(map[string][]byte, len(data)+4)
+	for k, v := range data {
+		rotated[k] = v
+	}
+	changed := false
+	ca, caKey, err := m.validateCA(data)
+	if err != nil {
+		klog.Infof("Rotating the webhook CA: %v", err)
+		if ca, caKey, err = m.generateCA(); err != nil {
+			return nil, false, err
+		}
+		keyDER, err := x509.MarshalECPrivateKey(caKey)
+		if err != nil {
+			return nil, false, err
+		}
+		rotated[caKeyKey] = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+		changed = true
+	}
+	if bundle := m.caBundle(ca, data[caCertKey]); !bytes.Equal(bundle, data[caCertKey]) {
+		rotated[caCertKey] = bundle
+		changed = true
+	}
+	if err := m.validateLeaf(data, ca); err != nil {
+		klog.Infof("Rotating the webhook serving certificate: %v", err)
+		cert, key, err := m.generateLeaf(ca, caKey)
+		if err != nil {
+			return nil, false, err
+		}
+		rotated[corev1.TLSCertKey] = cert
+		rotated[corev1.TLSPrivateKeyKey] = key
+		changed = true
+	}
+	return rotated, changed, nil
+}
+
+// validateCA returns the current CA of data and its key, if they match and the
+// CA outlives a serving certificate issued now.
+func (m *CertManager) validateCA(data map[string][]byte) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	ca, err := parseCertificate(data[caCertKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CA certificate: %w", err)
+	}
+	block, _ := pem.Decode(data[caKeyKey])
+	if block == nil {
+		return nil, nil, errors.New("missing CA key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CA key: %w", err)
+	}
+	if !key.PublicKey.Equal(ca.PublicKey) {
+		return nil, nil, errors.New("CA key doesn't match the CA certificate")
+	}
+	if m.now().Add(leafValidity).After(ca.NotAfter) {
+		return nil, nil, fmt.Errorf("CA expires at %s", ca.NotAfter)
+	}
+	return ca, key, nil
+}
+
+// caBundle returns the PEM bundle of ca followed by the previous CA found in
+// the old bundle, as long as it is within the overlap after the rotation and
+// has not expired.
+func (m *CertManager) caBundle(ca *x509.Certificate, old []byte) []byte {
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})
+	now := m.now()
+	// New CAs are backdated by an hour.
+	if now.After(ca.NotBefore.Add(time.Hour + caOverlap)) {
+		return bundle
+	}
+	for block, rest := pem.Decode(old); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" || bytes.Equal(block.Bytes, ca.Raw) {
+			continue
+		}
+		if prev, err := x509.ParseCertificate(block.Bytes); err == nil && now.Before(prev.NotAfter) {
+			return append(bundle, pem.EncodeToMemory(block)...)
+		}
+		return bundle
+	}
+	return bundle
+}
+
+// validateLeaf checks that data holds a serving certificate signed by ca that
+// is valid for the Service and does not expire soon.
+func (m *CertManager) validateLeaf(data map[string][]byte, ca *x509.Certificate) error {
+	if _, err := tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey]); err != nil {
+		return fmt.Errorf("invalid key pair: %w", err)
+	}
+	leaf, err := parseCertificate(data[corev1.TLSCertKey])
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	now := m.now()
+	for _, name := range m.dnsNames() {
+		if _, err := leaf.Verify(x509.VerifyOptions{DNSName: name, Roots: pool, CurrentTime: now}); err != nil {
+			return err
+		}
+	}
+	if now.Add(renewBefore).After(leaf.NotAfter) {
+		return fmt.Errorf("serving certificate expires at %s", leaf.NotAfter)
+	}
+	return nil
+}
+
+// generateCA creates a new self-signed CA.
+func (m *CertManager) generateCA() (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	now := m.now()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          newSerialNumber(),
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s-ca", m.config.ServiceName)},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	ca, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ca, key, nil
+}
+
+// generateLeaf creates a new serving certificate signed by ca, and returns it
+// and its key in PEM.
+func (m *CertManager) generateLeaf(ca *x509.Certificate, caKey *ecdsa.PrivateKey) ([]byte, []byte, error) {
+	now := m.now()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	dnsNames := m.dnsNames()
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject:      pkix.Name{CommonName: dnsNames[2]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(leafValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+// injectCABundle sets caBundle on every webhook of the Mutating and
+// ValidatingWebhookConfigurations. Missing configurations are skipped, so
+// that the webhooks can be left out of the deployment.
+func (m *CertManager) injectCABundle(ctx context.Context, caBundle []byte) error {
+	name := m.config.WebhookConfigurationName
+	admission := m.client.AdmissionregistrationV1()
+
+	mutating, err := admission.MutatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		klog.Warningf("MutatingWebhookConfiguration %s not found, skipping CA injection", name)
+	case err != nil:
+		return fmt.Errorf("getting MutatingWebhookConfiguration: %w", err)
+	default:
+		changed := false
+		for i := range mutating.Webhooks {
+			if !bytes.Equal(mutating.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				mutating.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if _, err := admission.MutatingWebhookConfigurations().Update(ctx, mutating, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("updating MutatingWebhookConfiguration: %w", err)
+			}
+		}
+	}
+
+	validating, err := admission.ValidatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		klog.Warningf("ValidatingWebhookConfiguration %s not found, skipping CA injection", name)
+	case err != nil:
+		return fmt.Errorf("getting ValidatingWebhookConfiguration: %w", err)
+	default:
+		changed := false
+		for i := range validating.Webhooks {
+			if !bytes.Equal(validating.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				validating.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if _, err := admission.ValidatingWebhookConfigurations().Update(ctx, validating, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("updating ValidatingWebhookConfiguration: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// injectConversionCABundle sets caBundle on the conversion webhook of the CRD.
+// It does nothing if the CRD is missing or does not use a conversion webhook.
+func (m *CertManager) injectConversionCABundle(ctx context.Context, caBundle []byte) error {
+	crds := m.crdClient.ApiextensionsV1().CustomResourceDefinitions()
+	crd, err := crds.Get(ctx, m.config.CRDName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		klog.Warningf("CustomResourceDefinition %s not found, skipping CA injection", m.config.CRDName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting CustomResourceDefinition: %w", err)
+	}
+	conversion := crd.Spec.Conversion
+	if conversion == nil || conversion.Strategy != apiextensionsv1.WebhookConverter ||
+		conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+		return nil
+	}
+	if bytes.Equal(conversion.Webhook.ClientConfig.CABundle, caBundle) {
+		return nil
+	}
+	conversion.Webhook.ClientConfig.CABundle = caBundle
+	if _, err := crds.Update(ctx, crd, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating CustomResourceDefinition: %w", err)
+	}
+	return nil
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid PEM certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func newSerialNumber() *big.Int {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return big.NewInt(time.Now().UnixNano())
+	}
+	return serial
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var testCertConfig = CertConfig{
+	Namespace:                "group-operator",
+	ServiceName:              "group-operator-webhook",
+	SecretName:               "group-operator-webhook-cert",
+	WebhookConfigurationName: "group-operator",
+	CRDName:                  "groupjobs.coreweave.com",
+}
+
+func getSecret(t *testing.T, client *fake.Clientset) *corev1.Secret {
+	t.Helper()
+	secret, err := client.CoreV1().Secrets(testCertConfig.Namespace).Get(context.Background(), testCertConfig.SecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting secret: %v", err)
+	}
+	return secret
+}
+
+func TestEnsureCertificates(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: testCertConfig.WebhookConfigurationName},
+			Webhooks:   []admissionregistrationv1.MutatingWebhook{{Name: "mutate.groupjobs.coreweave.com"}},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: testCertConfig.WebhookConfigurationName},
+			Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "validate.groupjobs.coreweave.com"}},
+		},
+	)
+	crdClient := apiextensionsfake.NewSimpleClientset(&apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: testCertConfig.CRDName},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook: &apiextensionsv1.WebhookConversion{
+					ClientConfig:             &apiextensionsv1.WebhookClientConfig{},
+					ConversionReviewVersions: []string{"v1"},
+				},
+			},
+		},
+	})
+	m := NewCertManager(client, crdClient, testCertConfig)
+	if _, err := m.GetCertificate(nil); err == nil {
+		t.Error("Expected an error before the certificate is loaded")
+	}
+	if err := m.Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	secret := getSecret(t, client)
+	if secret.Type != corev1.SecretTypeTLS {
+		t.Errorf("Secret type %q, want %q", secret.Type, corev1.SecretTypeTLS)
+	}
+	checkCertificates(t, m, secret.Data)
+	cert, err := m.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("Parsing serving certificate: %v", err)
+	}
+	if err := leaf.VerifyHostname("group-operator-webhook.group-operator.svc"); err != nil {
+		t.Errorf("Serving certificate: %v", err)
+	}
+
+	caBundle := secret.Data[caCertKey]
+	mutating, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, testCertConfig.WebhookConfigurationName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting MutatingWebhookConfiguration: %v", err)
+	}
+	if !bytes.Equal(mutating.Webhooks[0].ClientConfig.CABundle, caBundle) {
+		t.Error("CA bundle not injected into the MutatingWebhookConfiguration")
+	}
+	validating, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, testCertConfig.WebhookConfigurationName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting ValidatingWebhookConfiguration: %v", err)
+	}
+	if !bytes.Equal(validating.Webhooks[0].ClientConfig.CABundle, caBundle) {
+		t.Error("CA bundle not injected into the ValidatingWebhookConfiguration")
+	}
+	crd, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, testCertConfig.CRDName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting CustomResourceDefinition: %v", err)
+	}
+	if !bytes.Equal(crd.Spec.Conversion.Webhook.ClientConfig.CABundle, caBundle) {
+		t.Error("CA bundle not injected into the conversion webhook")
+	}
+
+	// A valid secret is reused, even by another replica.
+	if err := NewCertManager(client, crdClient, testCertConfig).Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	if got := getSecret(t, client); !bytes.Equal(got.Data[corev1.TLSCertKey], secret.Data[corev1.TLSCertKey]) {
+		t.Error("Valid certificate was regenerated")
+	}
+}
+
+func checkCertificates(t *testing.T, m *CertManager, data map[string][]byte) {
+	t.Helper()
+	ca, _, err := m.validateCA(data)
+	if err != nil {
+		t.Fatalf("Invalid CA: %v", err)
+	}
+	if err := m.validateLeaf(data, ca); err != nil {
+		t.Errorf("Invalid serving certificate: %v", err)
+	}
+}
+
+func TestEnsureCertificatesRotation(t *testing.T) {
+	ctx := context.Background()
+	cases := map[string]struct {
+		tweak         func(*CertManager, *corev1.Secret)
+		wantCARotated bool
+	}{
+		"expiring certificate": {
+			tweak: func(m *CertManager, _ *corev1.Secret) {
+				m.now = func() time.Time { return time.Now().Add(leafValidity - renewBefore/2) }
+			},
+		},
+		"service renamed": {
+			tweak: func(m *CertManager, _ *corev1.Secret) {
+				m.config.ServiceName = "renamed"
+			},
+		},
+		"corrupted secret": {
+			tweak: func(_ *CertManager, secret *corev1.Secret) {
+				secret.Data[corev1.TLSPrivateKeyKey] = []byte("garbage")
+			},
+		},
+		"expiring CA": {
+			tweak: func(m *CertManager, _ *corev1.Secret) {
+				m.now = func() time.Time { return time.Now().Add(caValidity - leafValidity/2) }
+			},
+			wantCARotated: true,
+		},
+		"missing CA key": {
+			tweak: func(_ *CertManager, secret *corev1.Secret) {
+				delete(secret.Data, caKeyKey)
+			},
+			wantCARotated: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			crdClient := apiextensionsfake.NewSimpleClientset()
+			if err := NewCertManager(client, crdClient, testCertConfig).Ensure(ctx); err != nil {
+				t.Fatalf("Ensure: %v", err)
+			}
+			secret := getSecret(t, client)
+			original := secret.DeepCopy()
+			m := NewCertManager(client, crdClient, testCertConfig)
+			tc.tweak(m, secret)
+			if _, err := client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+				t.Fatalf("Updating secret: %v", err)
+			}
+			if err := m.Ensure(ctx); err != nil {
+				t.Fatalf("Ensure: %v", err)
+			}
+			got := getSecret(t, client)
+			if bytes.Equal(got.Data[corev1.TLSCertKey], original.Data[corev1.TLSCertKey]) {
+				t.Error("Certificate was not regenerated")
+			}
+			checkCertificates(t, m, got.Data)
+			if !tc.wantCARotated {
+				if !bytes.Equal(got.Data[caCertKey], original.Data[caCertKey]) || !bytes.Equal(got.Data[caKeyKey], original.Data[caKeyKey]) {
+					t.Error("CA was rotated with the serving certificate")
+				}
+				return
+			}
+			if bytes.HasPrefix(got.Data[caCertKey], original.Data[caCertKey]) {
+				t.Error("CA was not rotated")
+			}
+			// The previous CA stays trusted during the overlap.
+			if !bytes.HasSuffix(got.Data[caCertKey], original.Data[caCertKey]) {
+				t.Error("Previous CA missing from the CA bundle")
+			}
+			now := m.now
+			m.now = func() time.Time { return now().Add(2 * caOverlap) }
+			if err := m.Ensure(ctx); err != nil {
+				t.Fatalf("Ensure: %v", err)
+			}
+			if got := getSecret(t, client); bytes.Contains(got.Data[caCertKey], original.Data[caCertKey]) {
+				t.Error("Previous CA still in the CA bundle after the overlap")
+			}
+		})
+	}
+}
+
+func TestEnsureCertificatesConflict(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	crdClient := apiextensionsfake.NewSimpleClientset()
+	if err := NewCertManager(client, crdClient, testCertConfig).Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	original := getSecret(t, client)
+	// Another replica rotates the certificates first.
+	client.PrependReactor("update", "secrets", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(corev1.Resource("secrets"), testCertConfig.SecretName, errors.New("modified"))
+	})
+	m := NewCertManager(client, crdClient, testCertConfig)
+	m.now = func() time.Time { return time.Now().Add(leafValidity - renewBefore/2) }
+	if err := m.Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	cert, err := m.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate: %v", err)
+	}
+	if want := original.Data[corev1.TLSCertKey]; !bytes.Equal(pemCertificate(cert), want) {
+		t.Error("Serving certificate isn't the one in the secret")
+	}
+}
+
+func TestRunReloadsCertificate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := fake.NewSimpleClientset()
+	crdClient := apiextensionsfake.NewSimpleClientset()
+	m := NewCertManager(client, crdClient, testCertConfig)
+	go m.Run(ctx)
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		_, err := m.GetCertificate(nil)
+		return err == nil, nil
+	}); err != nil {
+		t.Fatalf("Waiting for the serving certificate: %v", err)
+	}
+
+	// Another replica rotates the serving certificate.
+	other := NewCertManager(client, crdClient, testCertConfig)
+	other.now = func() time.Time { return time.Now().Add(leafValidity - renewBefore/2) }
+	if err := other.Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	want := getSecret(t, client).Data[corev1.TLSCertKey]
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		cert, err := m.GetCertificate(nil)
+		return err == nil && bytes.Equal(pemCertificate(cert), want), nil
+	}); err != nil {
+		t.Errorf("Rotated serving certificate was not reloaded: %v", err)
+	}
+}
+
+func pemCertificate(cert *tls.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+}
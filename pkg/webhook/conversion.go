@@ -0,0 +1,120 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog"
+
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// ConvertPath is the path of the CRD conversion webhook for GroupJobs.
+const ConvertPath = "/convert"
+
+// conversionScheme knows every served GroupJob version and the conversions
+// between them.
+var conversionScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(kubeflowv1.AddToScheme(conversionScheme))
+	utilruntime.Must(kubeflow.AddToScheme(conversionScheme))
+}
+
+func serveConversion(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	review := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil {
+		http.Error(w, fmt.Sprintf("decoding ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "ConversionReview has no request", http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, &apiextensionsv1.ConversionReview{
+		TypeMeta: review.TypeMeta,
+		Response: convert(review.Request),
+	})
+}
+
+// convert converts every object of the request to the desired API version.
+// The whole request fails if any object cannot be converted.
+func convert(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	response := &apiextensionsv1.ConversionResponse{UID: req.UID}
+	desired, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		response.Result = conversionFailure(err)
+		return response
+	}
+	for i := range req.Objects {
+		out, err := convertObject(req.Objects[i].Raw, desired)
+		if err != nil {
+			response.Result = conversionFailure(err)
+			response.ConvertedObjects = nil
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: out})
+	}
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+func convertObject(raw []byte, desired schema.GroupVersion) ([]byte, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, typeMeta); err != nil {
+		return nil, fmt.Errorf("decoding object type: %w", err)
+	}
+	gvk := typeMeta.GroupVersionKind()
+	if gvk.GroupVersion() == desired {
+		return raw, nil
+	}
+	in, err := conversionScheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, in); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", gvk, err)
+	}
+	out, err := conversionScheme.New(desired.WithKind(gvk.Kind))
+	if err != nil {
+		return nil, err
+	}
+	if err := conversionScheme.Convert(in, out, nil); err != nil {
+		return nil, fmt.Errorf("converting %s to %s: %w", gvk, desired, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(desired.WithKind(gvk.Kind))
+	return json.Marshal(out)
+}
+
+func conversionFailure(err error) metav1.Status {
+	klog.Errorf("Failed to convert GroupJob: %v", err)
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
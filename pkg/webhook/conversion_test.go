@@ -0,0 +1,163 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kubeflowv1 "github.com/coreweave/group-operator/pkg/apis/kubeflow/v1"
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func conversionReview(t *testing.T, desired string, objects ...interface{}) *apiextensionsv1.ConversionResponse {
+	t.Helper()
+	req := &apiextensionsv1.ConversionRequest{
+		UID:               types.UID("uid"),
+		DesiredAPIVersion: desired,
+	}
+	for _, obj := range objects {
+		req.Objects = append(req.Objects, runtime.RawExtension{Raw: mustMarshal(t, obj)})
+	}
+	body := mustMarshal(t, &apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "ConversionReview",
+		},
+		Request: req,
+	})
+	recorder := httptest.NewRecorder()
+	Handler(nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ConvertPath, bytes.NewReader(body)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Got status code %d, want %d: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+	}
+	got := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), got); err != nil {
+		t.Fatalf("Decoding ConversionReview: %v", err)
+	}
+	if got.Response == nil {
+		t.Fatal("ConversionReview has no response")
+	}
+	if got.Response.UID != req.UID {
+		t.Errorf("Got UID %q, want %q", got.Response.UID, req.UID)
+	}
+	return got.Response
+}
+
+func mustMarshal(t *testing.T, obj interface{}) []byte {
+	t.Helper()
+	out, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("Encoding %T: %v", obj, err)
+	}
+	return out
+}
+
+func newV2beta1GroupJobWithGroups() *kubeflow.GroupJob {
+	job := newGroupJob()
+	job.Spec.MPIReplicaSpecs["gpu"] = &kubeflow.ReplicaSpec{
+		Replicas: ptr.To[int32](4),
+		Template: job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template,
+	}
+	job.Status = kubeflow.JobStatus{
+		ObservedGeneration: 3,
+		ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+			kubeflow.MPIReplicaTypeWorker: {Active: 2},
+			"gpu":                         {Active: 4},
+		},
+	}
+	return job
+}
+
+func TestConvert(t *testing.T) {
+	v2beta1Job := newV2beta1GroupJobWithGroups()
+	resp := conversionReview(t, kubeflowv1.SchemeGroupVersion.String(), v2beta1Job)
+	if resp.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("Conversion failed: %s", resp.Result.Message)
+	}
+	if len(resp.ConvertedObjects) != 1 {
+		t.Fatalf("Got %d converted objects, want 1", len(resp.ConvertedObjects))
+	}
+	v1Job := &kubeflowv1.GroupJob{}
+	if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, v1Job); err != nil {
+		t.Fatalf("Decoding converted object: %v", err)
+	}
+	if v1Job.APIVersion != kubeflowv1.SchemeGroupVersion.String() || v1Job.Kind != kubeflowv1.Kind {
+		t.Errorf("Converted object has type %s %s", v1Job.APIVersion, v1Job.Kind)
+	}
+	if v1Job.Spec.Launcher == nil || v1Job.Spec.Worker == nil {
+		t.Fatalf("Converted object misses the launcher or the worker: %+v", v1Job.Spec)
+	}
+	if len(v1Job.Spec.WorkerGroups) != 1 || v1Job.Spec.WorkerGroups[0].Name != "gpu" {
+		t.Errorf("Got worker groups %+v, want a single group named gpu", v1Job.Spec.WorkerGroups)
+	}
+	if v1Job.Status.ObservedGeneration != 3 || len(v1Job.Status.WorkerGroups) != 1 || v1Job.Status.WorkerGroups[0].Active != 4 {
+		t.Errorf("Got unexpected status %+v", v1Job.Status)
+	}
+
+	// Converting back must return the original object.
+	resp = conversionReview(t, kubeflow.SchemeGroupVersion.String(), v1Job)
+	if resp.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("Conversion failed: %s", resp.Result.Message)
+	}
+	got := &kubeflow.GroupJob{}
+	if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, got); err != nil {
+		t.Fatalf("Decoding converted object: %v", err)
+	}
+	if diff := cmp.Diff(v2beta1Job, got); diff != "" {
+		t.Errorf("Unexpected round trip result (-want,+got):\n%s", diff)
+	}
+}
+
+func TestConvertSameVersion(t *testing.T) {
+	job := newGroupJob()
+	resp := conversionReview(t, kubeflow.SchemeGroupVersion.String(), job)
+	if resp.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("Conversion failed: %s", resp.Result.Message)
+	}
+	if want := mustMarshal(t, job); !bytes.Equal(resp.ConvertedObjects[0].Raw, want) {
+		t.Errorf("Got object %s, want it unchanged %s", resp.ConvertedObjects[0].Raw, want)
+	}
+}
+
+func TestConvertFailure(t *testing.T) {
+	v1Job := &kubeflowv1.GroupJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubeflowv1.SchemeGroupVersion.String(),
+			Kind:       kubeflowv1.Kind,
+		},
+		Spec: kubeflowv1.GroupJobSpec{
+			Worker:       &kubeflowv1.ReplicaSpec{},
+			WorkerGroups: []kubeflowv1.WorkerGroupSpec{{Name: string(kubeflow.MPIReplicaTypeWorker)}},
+		},
+	}
+	resp := conversionReview(t, kubeflow.SchemeGroupVersion.String(), newGroupJob(), v1Job)
+	if resp.Result.Status != metav1.StatusFailure {
+		t.Errorf("Got status %q, want %q", resp.Result.Status, metav1.StatusFailure)
+	}
+	if len(resp.ConvertedObjects) != 0 {
+		t.Errorf("Got %d converted objects on failure, want none", len(resp.ConvertedObjects))
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/coreweave/group-operator/pkg/apis/kubeflow/validation"
+	clientset "github.com/coreweave/group-operator/pkg/client/clientset/versioned"
+)
+
+const (
+	// MutatePath is the path of the mutating webhook for GroupJobs.
+	MutatePath = "/mutate-coreweave-com-v2beta1-groupjob"
+	// ValidatePath is the path of the validating webhook for GroupJobs.
+	ValidatePath = "/validate-coreweave-com-v2beta1-groupjob"
+
+	// maxRequestBytes bounds the size of a review body.
+	maxRequestBytes = 3 * 1024 * 1024
+)
+
+// Server serves the admission and conversion webhooks for GroupJobs.
+type Server struct {
+	port   int
+	certs  *CertManager
+	client clientset.Interface
+}
+
+// NewServer returns a Server listening on the given port with the
+// certificate served by certs. client is used to get the GroupJobs whose
+// scale subresource is updated.
+func NewServer(port int, certs *CertManager, client clientset.Interface) *Server {
+	return &Server{port: port, certs: certs, client: client}
+}
+
+// Handler returns the HTTP handler of the webhooks.
+func Handler(client clientset.Interface) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(MutatePath, serve(mutate))
+	mux.HandleFunc(ValidatePath, serve(func(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+		if req.SubResource == "scale" {
+			return validateScale(client, req)
+		}
+		return validate(req)
+	}))
+	mux.HandleFunc(ConvertPath, serveConversion)
+	return mux
+}
+
+// Run serves the webhooks over TLS until ctx is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.port),
+		Handler:           Handler(s.client),
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: s.certs.GetCertificate,
+		},
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	klog.Infof("Start listening to %d for admission webhooks", s.port)
+	if err := server.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+type admitFunc func(*admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+func serve(admit admitFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		review := &admissionv1.AdmissionReview{}
+		if err := json.Unmarshal(body, review); err != nil {
+			http.Error(w, fmt.Sprintf("decoding AdmissionReview: %v", err), http.StatusBadRequest)
+			return
+		}
+		if review.Request == nil {
+			http.Error(w, "AdmissionReview has no request", http.StatusBadRequest)
+			return
+		}
+		response := admit(review.Request)
+		response.UID = review.Request.UID
+		writeJSON(w, &admissionv1.AdmissionReview{
+			TypeMeta: review.TypeMeta,
+			Response: response,
+		})
+	}
+}
+
+// readBody reads the body of a review request. It replies with an error and
+// returns false if the request is not a POST or the body cannot be read.
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("reading request body: %v", err), http.StatusBadRequest)
+		return nil, false
+	}
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, review interface{}) {
+	out, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("encoding review: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(out); err != nil {
+		klog.Errorf("Failed to write webhook response: %v", err)
+	}
+}
+
+// mutate applies the GroupJob defaults and returns them as a JSON patch, so
+// that the persisted object matches what the controller operates on.
+func mutate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	job := &kubeflow.GroupJob{}
+	if err := json.Unmarshal(req.Object.Raw, job); err != nil {
+		return errorResponse(err)
+	}
+	kubeflow.SetObjectDefaults_GroupJob(job)
+	defaulted, err := json.Marshal(job)
+	if err != nil {
+		return errorResponse(err)
+	}
+	patch, err := jsonpatch.CreatePatch(req.Object.Raw, defaulted)
+	if err != nil {
+		return errorResponse(err)
+	}
+	response := &admissionv1.AdmissionResponse{Allowed: true}
+	if len(patch) != 0 {
+		if response.Patch, err = json.Marshal(patch); err != nil {
+			return errorResponse(err)
+		}
+		response.PatchType = ptr.To(admissionv1.PatchTypeJSONPatch)
+	}
+	return response
+}
+
+// validate rejects GroupJobs that the controller would fail on, and updates
+// that change the immutable parts of a running GroupJob.
+func validate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	job := &kubeflow.GroupJob{}
+	if err := json.Unmarshal(req.Object.Raw, job); err != nil {
+		return errorResponse(err)
+	}
+	kubeflow.SetObjectDefaults_GroupJob(job)
+	var errs field.ErrorList
+	switch req.Operation {
+	case admissionv1.Create:
+		errs = validation.ValidateGroupJob(job)
+	case admissionv1.Update:
+		oldJob := &kubeflow.GroupJob{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldJob); err != nil {
+			return errorResponse(err)
+		}
+		kubeflow.SetObjectDefaults_GroupJob(oldJob)
+		errs = validation.ValidateGroupJobUpdate(oldJob, job)
+	}
+	return validationResponse(job, errs)
+}
+
+// validateScale rejects updates of the scale subresource that would make the
+// GroupJob invalid, such as taking its workers out of the range of
+// minReplicas and maxReplicas.
+func validateScale(client clientset.Interface, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	scale := &autoscalingv1.Scale{}
+	if err := json.Unmarshal(req.Object.Raw, scale); err != nil {
+		return errorResponse(err)
+	}
+	oldJob, err := client.KubeflowV2beta1().GroupJobs(req.Namespace).Get(context.TODO(), req.Name, metav1.GetOptions{})
+	if err != nil {
+		return errorResponse(err)
+	}
+	kubeflow.SetObjectDefaults_GroupJob(oldJob)
+	job := oldJob.DeepCopy()
+	worker := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	if worker == nil {
+		return errorResponse(fmt.Errorf("GroupJob %s has no %s replica spec to scale", job.Name, kubeflow.MPIReplicaTypeWorker))
+	}
+	worker.Replicas = ptr.To(scale.Spec.Replicas)
+	return validationResponse(job, validation.ValidateGroupJobUpdate(oldJob, job))
+}
+
+// validationResponse denies the request if the GroupJob has validation errors.
+func validationResponse(job *kubeflow.GroupJob, errs field.ErrorList) *admissionv1.AdmissionResponse {
+	if len(errs) != 0 {
+		return &admissionv1.AdmissionResponse{
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusUnprocessableEntity,
+				Reason:  metav1.StatusReasonInvalid,
+				Message: fmt.Sprintf("GroupJob %s is invalid: %s", job.Name, errs.ToAggregate()),
+			},
+		}
+	}
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func errorResponse(err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusBadRequest,
+			Reason:  metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/coreweave/group-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/coreweave/group-operator/pkg/client/clientset/versioned/fake"
+)
+
+func newGroupJob() *kubeflow.GroupJob {
+	return &kubeflow.GroupJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubeflow.SchemeGroupVersion.String(),
+			Kind:       "GroupJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+		},
+		Spec: kubeflow.GroupJobSpec{
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Image: "foo"}},
+						},
+					},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas: ptr.To[int32](2),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Image: "foo"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func review(t *testing.T, path string, req *admissionv1.AdmissionRequest, objects ...runtime.Object) *admissionv1.AdmissionResponse {
+	t.Helper()
+	body, err := json.Marshal(&admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionv1.SchemeGroupVersion.String(),
+			Kind:       "AdmissionReview",
+		},
+		Request: req,
+	})
+	if err != nil {
+		t.Fatalf("Encoding AdmissionReview: %v", err)
+	}
+	recorder := httptest.NewRecorder()
+	Handler(fake.NewSimpleClientset(objects...)).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code %d: %s", recorder.Code, recorder.Body.String())
+	}
+	got := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), got); err != nil {
+		t.Fatalf("Decoding AdmissionReview: %v", err)
+	}
+	if got.Kind != "AdmissionReview" || got.Response == nil {
+		t.Fatalf("Unexpected AdmissionReview: %+v", got)
+	}
+	if got.Response.UID != req.UID {
+		t.Errorf("Response UID %q, want %q", got.Response.UID, req.UID)
+	}
+	return got.Response
+}
+
+func rawExtension(t *testing.T, job *kubeflow.GroupJob) runtime.RawExtension {
+	t.Helper()
+	raw, err := json.Marshal(job)
+	if err != nil {
+		t.Fatalf("Encoding GroupJob: %v", err)
+	}
+	return runtime.RawExtension{Raw: raw}
+}
+
+func TestMutate(t *testing.T) {
+	job := newGroupJob()
+	resp := review(t, MutatePath, &admissionv1.AdmissionRequest{
+		UID:       types.UID("uid"),
+		Operation: admissionv1.Create,
+		Object:    rawExtension(t, job),
+	})
+	if !resp.Allowed {
+		t.Fatalf("Request denied: %+v", resp.Result)
+	}
+	if resp.PatchType == nil || *resp.PatchType != admissionv1.PatchTypeJSONPatch {
+		t.Fatalf("Unexpected patch type %v", resp.PatchType)
+	}
+	var patch []jsonpatch.Operation
+	if err := json.Unmarshal(resp.Patch, &patch); err != nil {
+		t.Fatalf("Decoding patch: %v", err)
+	}
+	if len(patch) == 0 {
+		t.Fatal("Expected the defaults to be patched")
+	}
+
+	// The defaulted object doesn't need a patch.
+	kubeflow.SetObjectDefaults_GroupJob(job)
+	resp = review(t, MutatePath, &admissionv1.AdmissionRequest{
+		UID:       types.UID("uid"),
+		Operation: admissionv1.Create,
+		Object:    rawExtension(t, job),
+	})
+	if !resp.Allowed {
+		t.Fatalf("Request denied: %+v", resp.Result)
+	}
+	if resp.Patch != nil || resp.PatchType != nil {
+		t.Errorf("Unexpected patch for a defaulted GroupJob: %s", resp.Patch)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	startTime := metav1.Now()
+	running := newGroupJob()
+	running.Status.StartTime = &startTime
+
+	cases := map[string]struct {
+		operation   admissionv1.Operation
+		oldJob      *kubeflow.GroupJob
+		job         func() *kubeflow.GroupJob
+		wantAllowed bool
+	}{
+		"valid create": {
+			operation:   admissionv1.Create,
+			job:         newGroupJob,
+			wantAllowed: true,
+		},
+		"invalid create": {
+			operation: admissionv1.Create,
+			job: func() *kubeflow.GroupJob {
+				job := newGroupJob()
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](0)
+				return job
+			},
+		},
+		"scale a running job": {
+			operation: admissionv1.Update,
+			oldJob:    running,
+			job: func() *kubeflow.GroupJob {
+				job := running.DeepCopy()
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas = ptr.To[int32](4)
+				return job
+			},
+			wantAllowed: true,
+		},
+		"change the template of a running job": {
+			operation: admissionv1.Update,
+			oldJob:    running,
+			job: func() *kubeflow.GroupJob {
+				job := running.DeepCopy()
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "bar"
+				return job
+			},
+		},
+		"change the template of a pending job": {
+			operation: admissionv1.Update,
+			oldJob:    newGroupJob(),
+			job: func() *kubeflow.GroupJob {
+				job := newGroupJob()
+				job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = "bar"
+				return job
+			},
+			wantAllowed: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{
+				UID:       types.UID("uid"),
+				Operation: tc.operation,
+				Object:    rawExtension(t, tc.job()),
+			}
+			if tc.oldJob != nil {
+				req.OldObject = rawExtension(t, tc.oldJob)
+			}
+			resp := review(t, ValidatePath, req)
+			if diff := cmp.Diff(tc.wantAllowed, resp.Allowed); diff != "" {
+				t.Errorf("Unexpected allowed (-want,+got):\n%s\nResult: %+v", diff, resp.Result)
+			}
+			if !resp.Allowed && (resp.Result == nil || resp.Result.Reason != metav1.StatusReasonInvalid) {
+				t.Errorf("Unexpected result for a denied request: %+v", resp.Result)
+			}
+		})
+	}
+}
+
+func TestValidateScale(t *testing.T) {
+	job := newGroupJob()
+	job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].MinReplicas = ptr.To[int32](2)
+	job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].MaxReplicas = ptr.To[int32](4)
+	cases := map[string]struct {
+		replicas    int32
+		wantAllowed bool
+	}{
+		"within range": {
+			replicas:    3,
+			wantAllowed: true,
+		},
+		"below minReplicas": {
+			replicas: 1,
+		},
+		"above maxReplicas": {
+			replicas: 5,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw, err := json.Marshal(&autoscalingv1.Scale{
+				ObjectMeta: metav1.ObjectMeta{Name: job.Name, Namespace: job.Namespace},
+				Spec:       autoscalingv1.ScaleSpec{Replicas: tc.replicas},
+			})
+			if err != nil {
+				t.Fatalf("Encoding Scale: %v", err)
+			}
+			resp := review(t, ValidatePath, &admissionv1.AdmissionRequest{
+				UID:         types.UID("uid"),
+				Name:        job.Name,
+				Namespace:   job.Namespace,
+				Operation:   admissionv1.Update,
+				SubResource: "scale",
+				Object:      runtime.RawExtension{Raw: raw},
+			}, job)
+			if diff := cmp.Diff(tc.wantAllowed, resp.Allowed); diff != "" {
+				t.Errorf("Unexpected allowed (-want,+got):\n%s\nResult: %+v", diff, resp.Result)
+			}
+			if !resp.Allowed && (resp.Result == nil || resp.Result.Reason != metav1.StatusReasonInvalid) {
+				t.Errorf("Unexpected result for a denied request: %+v", resp.Result)
+			}
+		})
+	}
+}
+
+func TestServeBadRequest(t *testing.T) {
+	cases := map[string]struct {
+		method   string
+		body     string
+		wantCode int
+	}{
+		"wrong method": {
+			method:   http.MethodGet,
+			wantCode: http.StatusMethodNotAllowed,
+		},
+		"malformed body": {
+			method:   http.MethodPost,
+			body:     "{",
+			wantCode: http.StatusBadRequest,
+		},
+		"missing request": {
+			method:   http.MethodPost,
+			body:     `{"apiVersion":"admission.k8s.io/v1","kind":"AdmissionReview"}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			Handler(nil).ServeHTTP(recorder, httptest.NewRequest(tc.method, ValidatePath, bytes.NewBufferString(tc.body)))
+			if recorder.Code != tc.wantCode {
+				t.Errorf("Status code %d, want %d", recorder.Code, tc.wantCode)
+			}
+		})
+	}
+}
@@ -934,7 +934,11 @@ func startController(
 		kubeInformerFactory.Core().V1().Services(),
 		kubeInformerFactory.Batch().V1().Jobs(),
 		kubeInformerFactory.Core().V1().Pods(),
+		kubeInformerFactory.Core().V1().ServiceAccounts(),
+		kubeInformerFactory.Rbac().V1().Roles(),
+		kubeInformerFactory.Rbac().V1().RoleBindings(),
 		kubeInformerFactory.Scheduling().V1().PriorityClasses(),
+		kubeInformerFactory.Core().V1().Nodes(),
 		mpiInformerFactory.Kubeflow().V2beta1().GroupJobs(),
 		metav1.NamespaceAll, schedulerName,
 		workqueueRateLimiter,
@@ -1070,6 +1074,16 @@ func validateGroupJobStatus(ctx context.Context, t *testing.T, client clientset.
 		err    error
 		got    map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus
 	)
+	// The controller always reports the selector of the workers, which backs
+	// the /scale subresource.
+	if w := want[kubeflow.MPIReplicaTypeWorker]; w != nil && w.Selector == "" {
+		w.Selector = labels.SelectorFromSet(map[string]string{
+			kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+			kubeflow.JobNameLabel:      job.Name,
+			kubeflow.JobRoleLabel:      "worker",
+			kubeflow.ReplicaTypeLabel:  "worker",
+		}).String()
+	}
 	if err := wait.PollUntilContextTimeout(ctx, util.WaitInterval, wait.ForeverTestTimeout, false, func(ctx context.Context) (bool, error) {
 		newJob, err = client.KubeflowV2beta1().GroupJobs(job.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
 		if err != nil {